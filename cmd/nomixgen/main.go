@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+// Command nomixgen generates a typed wrapper around [nomix.TagSet] from tag
+// definitions described by a JSON Schema document. It is meant to be used
+// with go:generate after installing it with "go install" or running it with
+// "go run github.com/ctx42/nomix/cmd/nomixgen":
+//
+//	//go:generate nomixgen -in asset.json -type Asset
+//
+// Flags:
+//
+//	-in    JSON Schema file, required.
+//	-type  Name of the generated type, required.
+//	-pkg   Package name, defaults to the $GOPACKAGE environment variable.
+//	-out   Output file, defaults to the lower-cased type name with the
+//	       "_tags.go" suffix.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ctx42/nomix/pkg/nomixgen"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, "nomixgen:", err)
+		os.Exit(1)
+	}
+}
+
+// run runs the generator with the command line arguments.
+func run(args []string) error {
+	fs := flag.NewFlagSet("nomixgen", flag.ContinueOnError)
+	in := fs.String("in", "", "JSON Schema file")
+	typ := fs.String("type", "", "name of the generated type")
+	pkg := fs.String("pkg", os.Getenv("GOPACKAGE"), "package name")
+	out := fs.String("out", "", "output file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *in == "" || *typ == "" {
+		return fmt.Errorf("the -in and -type flags are required")
+	}
+	if *out == "" {
+		*out = strings.ToLower(*typ) + "_tags.go"
+	}
+
+	data, err := os.ReadFile(*in)
+	if err != nil {
+		return err
+	}
+	src, err := nomixgen.Generate(*pkg, *typ, data)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*out, src, 0o644)
+}
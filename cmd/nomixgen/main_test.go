@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+func Test_run(t *testing.T) {
+	t.Run("generate", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		in := filepath.Join(dir, "asset.json")
+		must.Nil(os.WriteFile(in, []byte(`{"type": "object"}`), 0o600))
+		out := filepath.Join(dir, "asset.go")
+
+		// --- When ---
+		err := run([]string{
+			"-in", in, "-type", "Asset", "-pkg", "asset", "-out", out,
+		})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		have := string(must.Value(os.ReadFile(out)))
+		assert.Contain(t, "package asset", have)
+		assert.Contain(t, "type Asset struct", have)
+	})
+
+	t.Run("default package and output", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		in := filepath.Join(dir, "asset.json")
+		must.Nil(os.WriteFile(in, []byte(`{"type": "object"}`), 0o600))
+		t.Setenv("GOPACKAGE", "asset")
+		t.Chdir(dir)
+
+		// --- When ---
+		err := run([]string{"-in", in, "-type", "Asset"})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		have := string(must.Value(os.ReadFile("asset_tags.go")))
+		assert.Contain(t, "package asset", have)
+	})
+
+	t.Run("error - required flags", func(t *testing.T) {
+		// --- When ---
+		err := run([]string{"-type", "Asset"})
+
+		// --- Then ---
+		assert.ErrorEqual(t, "the -in and -type flags are required", err)
+	})
+
+	t.Run("error - unknown flag", func(t *testing.T) {
+		// --- When ---
+		err := run([]string{"-unknown"})
+
+		// --- Then ---
+		assert.ErrorContain(t, "flag provided but not defined", err)
+	})
+
+	t.Run("error - input file", func(t *testing.T) {
+		// --- Given ---
+		in := filepath.Join(t.TempDir(), "missing.json")
+
+		// --- When ---
+		err := run([]string{"-in", in, "-type", "Asset", "-pkg", "asset"})
+
+		// --- Then ---
+		assert.ErrorIs(t, os.ErrNotExist, err)
+	})
+
+	t.Run("error - generate", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		in := filepath.Join(dir, "asset.json")
+		must.Nil(os.WriteFile(in, []byte(`{"type": "object"}`), 0o600))
+
+		// --- When ---
+		err := run([]string{"-in", in, "-type", "Asset", "-pkg", "a-b"})
+
+		// --- Then ---
+		assert.ErrorEqual(t, `invalid package name: "a-b"`, err)
+	})
+}
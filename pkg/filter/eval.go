@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package filter
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+)
+
+// anyElem returns true if the match function returns true for the value of
+// the named single value tag, or any of the elements of the named slice tag.
+// Returns false if the tag doesn't exist. The operator name is used in error
+// messages.
+func anyElem(
+	tags nomix.Tagger,
+	name string,
+	op string,
+	match func(elem any) (bool, error),
+) (bool, error) {
+
+	tag := tags.TagGet(name)
+	if tag == nil {
+		return false, nil
+	}
+	elems, err := tagElems(tag)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", name, err)
+	}
+	for _, elem := range elems {
+		ok, err := match(elem)
+		if errors.Is(err, ErrNotSupported) {
+			const format = "%s: %w: %s for %s"
+			return false, fmt.Errorf(format, name, err, op, tag.TagKind())
+		}
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", name, err)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// tagElems returns the tag value, or slice tag elements, normalized to
+// int64, float64, bool, string, [time.Time] or [16]byte types. JSON values
+// and byte slices, which cannot be compared, are returned as a single nil
+// element.
+func tagElems(tag nomix.Tag) ([]any, error) {
+	knd := tag.TagKind()
+	if knd.Base() == nomix.KindJSON || knd == nomix.KindByteSlice {
+		return []any{nil}, nil
+	}
+	val := reflect.ValueOf(tag.TagValue())
+	if !knd.IsSlice() {
+		elem, err := normalize(knd, val)
+		if err != nil {
+			return nil, err
+		}
+		return []any{elem}, nil
+	}
+	if val.Kind() != reflect.Slice {
+		return nil, nomix.ErrInvType
+	}
+	elems := make([]any, val.Len())
+	for i := range val.Len() {
+		elem, err := normalize(knd, val.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		elems[i] = elem
+	}
+	return elems, nil
+}
+
+// normalize converts the value of the given kind to one of int64, float64,
+// bool, string, [time.Time] or [16]byte types.
+//
+// nolint: cyclop
+func normalize(knd nomix.Kind, val reflect.Value) (any, error) {
+	switch knd.Base() {
+	case nomix.KindInt64:
+		switch val.Kind() {
+		case reflect.Bool:
+			return val.Bool(), nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+			reflect.Int64:
+			return val.Int(), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+			reflect.Uint64:
+			if val.Uint() > math.MaxInt64 {
+				return float64(val.Uint()), nil
+			}
+			return int64(val.Uint()), nil
+		}
+
+	case nomix.KindFloat64:
+		if val.CanFloat() {
+			return val.Float(), nil
+		}
+
+	case nomix.KindString:
+		if val.Kind() == reflect.String {
+			return val.String(), nil
+		}
+
+	case nomix.KindTime:
+		if v, ok := val.Interface().(time.Time); ok {
+			return v, nil
+		}
+
+	case nomix.KindUUID:
+		if v, ok := val.Interface().([16]byte); ok {
+			return v, nil
+		}
+	}
+	return nil, nomix.ErrInvType
+}
+
+// compare compares the normalized tag value with the filter value using the
+// operator.
+//
+// nolint: cyclop
+func compare(elem any, op Op, val any) (bool, error) {
+	switch v := elem.(type) {
+	case int64:
+		switch w := val.(type) {
+		case int64:
+			return result(op, cmp.Compare(v, w)), nil
+		case float64:
+			return result(op, cmp.Compare(float64(v), w)), nil
+		}
+
+	case float64:
+		switch w := val.(type) {
+		case int64:
+			return result(op, cmp.Compare(v, float64(w))), nil
+		case float64:
+			return result(op, cmp.Compare(v, w)), nil
+		}
+
+	case bool:
+		if w, ok := val.(bool); ok {
+			if op != OpEq && op != OpNe {
+				return false, ErrNotSupported
+			}
+			return result(op, boolCompare(v, w)), nil
+		}
+
+	case string:
+		if w, ok := val.(string); ok {
+			return result(op, strings.Compare(v, w)), nil
+		}
+
+	case time.Time:
+		if w, ok := val.(string); ok {
+			tim, err := nomix.ParseTime(w, nomix.NewOptions())
+			if err != nil {
+				return false, err
+			}
+			return result(op, v.Compare(tim)), nil
+		}
+
+	case [16]byte:
+		if w, ok := val.(string); ok {
+			if op != OpEq && op != OpNe {
+				return false, ErrNotSupported
+			}
+			tag, err := xtag.ParseUUID("", w)
+			if err != nil {
+				return false, nomix.ErrInvFormat
+			}
+			uid := tag.Get()
+			return result(op, bytes.Compare(v[:], uid[:])), nil
+		}
+
+	case nil:
+		return false, ErrNotSupported
+	}
+	return false, fmt.Errorf("%w: %T value", nomix.ErrInvType, val)
+}
+
+// boolCompare compares two boolean values, false is less than true.
+func boolCompare(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// result returns the result of the operator given the comparison result.
+func result(op Op, res int) bool {
+	switch op {
+	case OpEq:
+		return res == 0
+	case OpNe:
+		return res != 0
+	case OpLt:
+		return res < 0
+	case OpLe:
+		return res <= 0
+	case OpGt:
+		return res > 0
+	case OpGe:
+		return res >= 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package filter
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+func Test_normalize_tabular(t *testing.T) {
+	tim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tt := []struct {
+		testN string
+
+		knd nomix.Kind
+		val any
+		exp any
+	}{
+		{"bool", nomix.KindBool, true, true},
+		{"int", nomix.KindInt, 42, int64(42)},
+		{"int8", nomix.KindInt64, int8(-1), int64(-1)},
+		{"uint", nomix.KindInt64, uint16(7), int64(7)},
+		{
+			"uint overflow",
+			nomix.KindInt64,
+			uint64(math.MaxUint64),
+			float64(math.MaxUint64),
+		},
+		{"float32", nomix.KindFloat64, float32(0.5), 0.5},
+		{"string", nomix.KindString, "abc", "abc"},
+		{"time", nomix.KindTime, tim, tim},
+		{"uuid", nomix.KindUUID, [16]byte{1}, [16]byte{1}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := normalize(tc.knd, reflect.ValueOf(tc.val))
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_normalize(t *testing.T) {
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := normalize(nomix.KindInt64, reflect.ValueOf("abc"))
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_compare(t *testing.T) {
+	t.Run("unknown operator", func(t *testing.T) {
+		// --- When ---
+		have, err := compare(int64(1), Op(42), int64(1))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.False(t, have)
+	})
+
+	t.Run("bool ordering", func(t *testing.T) {
+		// --- When ---
+		have, err := compare(true, OpNe, false)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, have)
+	})
+
+	t.Run("error - not comparable", func(t *testing.T) {
+		// --- When ---
+		have, err := compare(nil, OpEq, int64(1))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotSupported, err)
+		assert.False(t, have)
+	})
+
+	t.Run("error - type mismatch", func(t *testing.T) {
+		// --- When ---
+		have, err := compare(int64(1), OpEq, "abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.ErrorEqual(t, "invalid element type: string value", err)
+		assert.False(t, have)
+	})
+}
+
+func Test_boolCompare(t *testing.T) {
+	assert.Equal(t, 0, boolCompare(true, true))
+	assert.Equal(t, -1, boolCompare(false, true))
+	assert.Equal(t, 1, boolCompare(true, false))
+}
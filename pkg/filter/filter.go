@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+// Package filter provides the tag filter expression language.
+//
+// Filter expressions select tag sets by their tags, for example:
+//
+//	env = "prod" AND replicas >= 3 AND region IN ("eu", "us")
+//
+// The language supports:
+//
+//   - Comparisons with =, !=, <, <=, > and >= operators. Values are string
+//     literals in double quotes, integer and float numbers, and TRUE or
+//     FALSE. Numeric tags are compared numerically, time tags are compared
+//     with values given as [time.RFC3339Nano] strings, and boolean and UUID
+//     tags support only equality operators.
+//   - Membership with IN and NOT IN operators and a list of values.
+//   - The CONTAINS operator checking if a slice tag has the element.
+//   - Existence checks with the EXISTS operator, for example: EXISTS env.
+//   - Logical AND, OR and NOT operators, and parentheses.
+//
+// Keywords are case-insensitive. Tag names consist of letters, digits and
+// the "_", ".", "-", "/" and ":" characters, other names must be quoted with
+// backticks, and backticks in quoted names are doubled. Comparisons of slice
+// tags are true if any of the elements satisfies them. Comparisons of tags
+// which are not in the set are false.
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+// Sentinel errors.
+var (
+	// ErrSyntax is returned when the filter expression is malformed.
+	ErrSyntax = errors.New("syntax error")
+
+	// ErrNotSupported is returned when the operator is not supported for
+	// the tag kind.
+	ErrNotSupported = errors.New("operator not supported")
+)
+
+// SyntaxError represents a malformed filter expression error.
+type SyntaxError struct {
+	Pos int    // Byte offset in the expression where the error was found.
+	Msg string // Error message.
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s at position %d: %s", ErrSyntax, e.Pos, e.Msg)
+}
+
+// Unwrap returns [ErrSyntax].
+func (e *SyntaxError) Unwrap() error { return ErrSyntax }
+
+// Compile time checks.
+var (
+	_ Expr = (*And)(nil)
+	_ Expr = (*Or)(nil)
+	_ Expr = (*Not)(nil)
+	_ Expr = (*Exists)(nil)
+	_ Expr = (*Compare)(nil)
+	_ Expr = (*In)(nil)
+	_ Expr = (*Contains)(nil)
+)
+
+// Expr represents a node of the filter expression tree.
+type Expr interface {
+	// Eval evaluates the expression against the tags. Returns an error if
+	// the expression cannot be evaluated, for example, when a value cannot
+	// be compared with the tag of its kind.
+	Eval(tags nomix.Tagger) (bool, error)
+
+	// String returns the expression in the filter language.
+	String() string
+}
+
+// Op represents the comparison operator.
+type Op int
+
+// Comparison operators.
+const (
+	OpEq Op = iota // Equal.
+	OpNe           // Not equal.
+	OpLt           // Less than.
+	OpLe           // Less than or equal.
+	OpGt           // Greater than.
+	OpGe           // Greater than or equal.
+)
+
+// String implements [fmt.Stringer].
+func (op Op) String() string {
+	switch op {
+	case OpEq:
+		return "="
+	case OpNe:
+		return "!="
+	case OpLt:
+		return "<"
+	case OpLe:
+		return "<="
+	case OpGt:
+		return ">"
+	case OpGe:
+		return ">="
+	default:
+		return "?"
+	}
+}
+
+// And represents the logical conjunction of two expressions.
+type And struct{ Left, Right Expr }
+
+func (e *And) Eval(tags nomix.Tagger) (bool, error) {
+	ok, err := e.Left.Eval(tags)
+	if err != nil || !ok {
+		return false, err
+	}
+	return e.Right.Eval(tags)
+}
+
+func (e *And) String() string {
+	return group(e.Left, false) + " AND " + group(e.Right, false)
+}
+
+// Or represents the logical disjunction of two expressions.
+type Or struct{ Left, Right Expr }
+
+func (e *Or) Eval(tags nomix.Tagger) (bool, error) {
+	ok, err := e.Left.Eval(tags)
+	if err != nil || ok {
+		return ok, err
+	}
+	return e.Right.Eval(tags)
+}
+
+func (e *Or) String() string {
+	return e.Left.String() + " OR " + e.Right.String()
+}
+
+// Not represents the logical negation of the expression.
+type Not struct{ Expr Expr }
+
+func (e *Not) Eval(tags nomix.Tagger) (bool, error) {
+	ok, err := e.Expr.Eval(tags)
+	if err != nil {
+		return false, err
+	}
+	return !ok, nil
+}
+
+func (e *Not) String() string { return "NOT " + group(e.Expr, true) }
+
+// Exists represents the check if the named tag exists.
+type Exists struct{ Name string }
+
+func (e *Exists) Eval(tags nomix.Tagger) (bool, error) {
+	return tags.TagGet(e.Name) != nil, nil
+}
+
+func (e *Exists) String() string { return "EXISTS " + quoteName(e.Name) }
+
+// Compare represents the comparison of the named tag with the value. The
+// value is a string, int64, float64 or bool.
+type Compare struct {
+	Name  string // Tag name.
+	Op    Op     // Comparison operator.
+	Value any    // Value to compare with.
+}
+
+func (e *Compare) Eval(tags nomix.Tagger) (bool, error) {
+	op := e.Op.String()
+	return anyElem(tags, e.Name, op, func(elem any) (bool, error) {
+		return compare(elem, e.Op, e.Value)
+	})
+}
+
+func (e *Compare) String() string {
+	return quoteName(e.Name) + " " + e.Op.String() + " " + quoteValue(e.Value)
+}
+
+// In represents the check if the named tag is equal to any of the values.
+// The values are strings, int64, float64 or bool.
+type In struct {
+	Name   string // Tag name.
+	Values []any  // Values to compare with.
+}
+
+func (e *In) Eval(tags nomix.Tagger) (bool, error) {
+	return anyElem(tags, e.Name, "IN", func(elem any) (bool, error) {
+		for _, val := range e.Values {
+			ok, err := compare(elem, OpEq, val)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	})
+}
+
+func (e *In) String() string {
+	vals := make([]string, len(e.Values))
+	for i, val := range e.Values {
+		vals[i] = quoteValue(val)
+	}
+	return quoteName(e.Name) + " IN (" + strings.Join(vals, ", ") + ")"
+}
+
+// Contains represents the check if the named slice tag has the element equal
+// to the value. The value is a string, int64, float64 or bool.
+type Contains struct {
+	Name  string // Tag name.
+	Value any    // Element value.
+}
+
+func (e *Contains) Eval(tags nomix.Tagger) (bool, error) {
+	if tag := tags.TagGet(e.Name); tag != nil && !tag.TagKind().IsSlice() {
+		const format = "%s: %w: CONTAINS for %s"
+		knd := tag.TagKind()
+		return false, fmt.Errorf(format, e.Name, ErrNotSupported, knd)
+	}
+	return anyElem(tags, e.Name, "CONTAINS", func(elem any) (bool, error) {
+		return compare(elem, OpEq, e.Value)
+	})
+}
+
+func (e *Contains) String() string {
+	return quoteName(e.Name) + " CONTAINS " + quoteValue(e.Value)
+}
+
+// group returns the expression string in parentheses if it is a logical
+// disjunction, or when all is set, also a conjunction.
+func group(e Expr, all bool) string {
+	switch e.(type) {
+	case *Or:
+		return "(" + e.String() + ")"
+	case *And:
+		if all {
+			return "(" + e.String() + ")"
+		}
+	}
+	return e.String()
+}
+
+// quoteName returns the tag name quoted with backticks when it contains
+// characters not allowed in bare names, or is a keyword. Backticks in the
+// name are doubled.
+func quoteName(name string) string {
+	if name == "" || isKeyword(name) {
+		return "`" + name + "`"
+	}
+	for i, r := range name {
+		if !isNameRune(r, i == 0) {
+			return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+		}
+	}
+	return name
+}
+
+// quoteValue returns the value as a literal of the filter language.
+func quoteValue(val any) string {
+	switch v := val.(type) {
+	case string:
+		return strconv.Quote(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		str := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(str, ".eEIN") {
+			str += ".0" // Make sure it is parsed back as a float.
+		}
+		return str
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
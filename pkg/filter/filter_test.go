@@ -0,0 +1,324 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+)
+
+// tstTagSet returns a tag set used in tests.
+func tstTagSet() nomix.TagSet {
+	set := nomix.NewTagSet()
+	set.TagSet(
+		xtag.NewString("env", "prod"),
+		xtag.NewInt("replicas", 3),
+		xtag.NewFloat64("load", 0.75),
+		xtag.NewBool("canary", false),
+		xtag.NewTime("created", time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)),
+		xtag.NewUUID("id", [16]byte{0x01, 0x02, 0x0F}),
+		xtag.NewStringSlice("zones", "eu-1", "eu-2"),
+		xtag.NewIntSlice("ports", 80, 443),
+		xtag.NewJSON("doc", []byte(`{"a":1}`)),
+		xtag.NewByteSlice("raw", 1, 2),
+	)
+	return set
+}
+
+func Test_SyntaxError_Error(t *testing.T) {
+	// --- Given ---
+	err := &SyntaxError{Pos: 3, Msg: "abc"}
+
+	// --- When ---
+	have := err.Error()
+
+	// --- Then ---
+	assert.Equal(t, "syntax error at position 3: abc", have)
+	assert.ErrorIs(t, ErrSyntax, err)
+}
+
+func Test_Op_String_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		op  Op
+		exp string
+	}{
+		{"eq", OpEq, "="},
+		{"ne", OpNe, "!="},
+		{"lt", OpLt, "<"},
+		{"le", OpLe, "<="},
+		{"gt", OpGt, ">"},
+		{"ge", OpGe, ">="},
+		{"unknown", Op(42), "?"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.op.String()
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_Expr_Eval_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		query string
+		exp   bool
+	}{
+		{"string eq", `env = "prod"`, true},
+		{"string ne", `env != "prod"`, false},
+		{"string lt", `env < "qa"`, true},
+		{"int eq", `replicas = 3`, true},
+		{"int ge", `replicas >= 3`, true},
+		{"int gt", `replicas > 3`, false},
+		{"int with float", `replicas < 3.5`, true},
+		{"float le", `load <= 0.75`, true},
+		{"float with int", `load > 1`, false},
+		{"bool eq", `canary = FALSE`, true},
+		{"bool ne", `canary != false`, false},
+		{"time gt", `created > "2000-01-01T00:00:00Z"`, true},
+		{"time eq", `created = "2000-01-02T03:04:05Z"`, true},
+		{"uuid eq", `id = "01020f00-0000-0000-0000-000000000000"`, true},
+		{"uuid upper", `id = "01020F00-0000-0000-0000-000000000000"`, true},
+		{"uuid ne", `id != "01020f00-0000-0000-0000-000000000000"`, false},
+		{"uuid braces", `id = "{01020f00-0000-0000-0000-000000000000}"`, true},
+		{"uuid no dashes", `id = "01020f00000000000000000000000000"`, true},
+		{
+			"uuid urn",
+			`id = "urn:uuid:01020f00-0000-0000-0000-000000000000"`,
+			true,
+		},
+		{"uuid other", `id = "01020f00-0000-0000-0000-000000000001"`, false},
+		{"slice any element", `zones = "eu-2"`, true},
+		{"slice no element", `zones = "us-1"`, false},
+		{"int slice any element", `ports > 100`, true},
+		{"in", `env IN ("qa", "prod")`, true},
+		{"in none", `env IN ("qa", "dev")`, false},
+		{"not in", `env NOT IN ("qa", "dev")`, true},
+		{"in slice", `ports IN (22, 443)`, true},
+		{"contains", `zones CONTAINS "eu-1"`, true},
+		{"contains none", `ports CONTAINS 22`, false},
+		{"exists", `EXISTS env`, true},
+		{"exists missing", `EXISTS abc`, false},
+		{"missing compare", `abc = 1`, false},
+		{"missing not compare", `NOT abc = 1`, true},
+		{"missing in", `abc IN (1)`, false},
+		{"missing contains", `abc CONTAINS 1`, false},
+		{"and", `env = "prod" AND replicas >= 3`, true},
+		{"and false", `env = "prod" AND replicas > 3`, false},
+		{"or", `env = "qa" OR replicas >= 3`, true},
+		{"or false", `env = "qa" OR replicas > 3`, false},
+		{"not", `NOT env = "qa"`, true},
+		{
+			"example",
+			`env = "prod" AND replicas >= 3 AND zones IN ("eu-1", "us")`,
+			true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			expr := MustParse(tc.query)
+
+			// --- When ---
+			have, err := expr.Eval(tstTagSet())
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_Expr_Eval_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		query string
+		exp   string
+	}{
+		{
+			"type mismatch",
+			`env = 1`,
+			"env: invalid element type: int64 value",
+		},
+		{
+			"bool ordering",
+			`canary < true`,
+			"canary: operator not supported: < for KindBool",
+		},
+		{
+			"uuid ordering",
+			`id > "abc"`,
+			"id: operator not supported: > for KindUUID",
+		},
+		{
+			"invalid time",
+			`created = "abc"`,
+			"created: invalid element format",
+		},
+		{
+			"invalid uuid",
+			`id = "01020f00"`,
+			"id: invalid element format",
+		},
+		{
+			"json",
+			`doc = "abc"`,
+			"doc: operator not supported: = for KindJSON",
+		},
+		{
+			"byte slice",
+			`raw IN (1)`,
+			"raw: operator not supported: IN for KindByteSlice",
+		},
+		{
+			"contains single value",
+			`env CONTAINS "prod"`,
+			"env: operator not supported: CONTAINS for KindString",
+		},
+		{
+			"not",
+			`NOT env = 1`,
+			"env: invalid element type: int64 value",
+		},
+		{
+			"and",
+			`EXISTS env AND env = 1`,
+			"env: invalid element type: int64 value",
+		},
+		{
+			"or",
+			`env = 1 OR EXISTS env`,
+			"env: invalid element type: int64 value",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			expr := MustParse(tc.query)
+
+			// --- When ---
+			have, err := expr.Eval(tstTagSet())
+
+			// --- Then ---
+			assert.ErrorContain(t, tc.exp, err)
+			assert.False(t, have)
+		})
+	}
+}
+
+func Test_Expr_Eval_short_circuit(t *testing.T) {
+	t.Run("and", func(t *testing.T) {
+		// --- Given ---
+		expr := MustParse(`EXISTS abc AND env = 1`)
+
+		// --- When ---
+		have, err := expr.Eval(tstTagSet())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.False(t, have)
+	})
+
+	t.Run("or", func(t *testing.T) {
+		// --- Given ---
+		expr := MustParse(`EXISTS env OR env = 1`)
+
+		// --- When ---
+		have, err := expr.Eval(tstTagSet())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, have)
+	})
+}
+
+func Test_Expr_String_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		query string
+		exp   string
+	}{
+		{"compare", `env="prod"`, `env = "prod"`},
+		{"int", `a >= 3`, `a >= 3`},
+		{"float", `a < 2.0`, `a < 2.0`},
+		{"float exponent", `a < 1e21`, `a < 1e+21`},
+		{"bool", `a = true`, `a = TRUE`},
+		{"in", `a in (1,"b")`, `a IN (1, "b")`},
+		{"not in", `a NOT IN (1)`, `NOT a IN (1)`},
+		{"contains", `a contains "b"`, `a CONTAINS "b"`},
+		{"exists", `exists a`, `EXISTS a`},
+		{"quoted name", "`a b` = 1", "`a b` = 1"},
+		{"keyword name", "`or` = 1", "`or` = 1"},
+		{"empty name", "`` = 1", "`` = 1"},
+		{"backtick in name", "`a``b` = 1", "`a``b` = 1"},
+		{"and", `EXISTS a AND EXISTS b`, `EXISTS a AND EXISTS b`},
+		{"or", `EXISTS a OR EXISTS b`, `EXISTS a OR EXISTS b`},
+		{
+			"or in and",
+			`(EXISTS a OR EXISTS b) AND EXISTS c`,
+			`(EXISTS a OR EXISTS b) AND EXISTS c`,
+		},
+		{
+			"and in or",
+			`EXISTS a AND EXISTS b OR EXISTS c`,
+			`EXISTS a AND EXISTS b OR EXISTS c`,
+		},
+		{
+			"and in not",
+			`NOT (EXISTS a AND EXISTS b)`,
+			`NOT (EXISTS a AND EXISTS b)`,
+		},
+		{
+			"redundant parentheses",
+			`((EXISTS a))`,
+			`EXISTS a`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			expr := MustParse(tc.query)
+
+			// --- When ---
+			have := expr.String()
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+			assert.Equal(t, expr, MustParse(have))
+		})
+	}
+}
+
+func Test_Expr_String_backtick_name(t *testing.T) {
+	for _, name := range []string{"a`b", "`", "``", "`a` b"} {
+		t.Run(name, func(t *testing.T) {
+			// --- Given ---
+			expr := &Compare{Name: name, Op: OpEq, Value: int64(1)}
+
+			// --- When ---
+			have, err := Parse(expr.String())
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, expr, have)
+		})
+	}
+}
@@ -0,0 +1,485 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Keywords.
+const (
+	kwAnd      = "AND"
+	kwOr       = "OR"
+	kwNot      = "NOT"
+	kwIn       = "IN"
+	kwExists   = "EXISTS"
+	kwContains = "CONTAINS"
+	kwTrue     = "TRUE"
+	kwFalse    = "FALSE"
+)
+
+// keywords lists all keywords.
+var keywords = []string{
+	kwAnd, kwOr, kwNot, kwIn, kwExists, kwContains, kwTrue, kwFalse,
+}
+
+// isKeyword returns true if the string is a keyword. The comparison is
+// case-insensitive.
+func isKeyword(str string) bool {
+	for _, kw := range keywords {
+		if strings.EqualFold(str, kw) {
+			return true
+		}
+	}
+	return false
+}
+
+// isNameRune returns true if the rune is allowed in bare tag names. The
+// first rune of the name must be a letter or an underscore.
+func isNameRune(r rune, first bool) bool {
+	if unicode.IsLetter(r) || r == '_' {
+		return true
+	}
+	if first {
+		return false
+	}
+	return unicode.IsDigit(r) || strings.ContainsRune(".-/:", r)
+}
+
+// Token types.
+const (
+	tokEOF     = iota // End of the expression.
+	tokName           // Tag name.
+	tokKeyword        // Keyword.
+	tokString         // String literal.
+	tokNumber         // Number literal.
+	tokOp             // Comparison operator.
+	tokLParen         // Left parenthesis.
+	tokRParen         // Right parenthesis.
+	tokComma          // Comma.
+)
+
+// token represents a lexical token.
+type token struct {
+	typ int    // Token type.
+	pos int    // Byte offset of the token in the expression.
+	str string // Token text, or the value for names and string literals.
+	val any    // Token value for literals and operators.
+}
+
+// describe returns the token description used in error messages.
+func (t token) describe() string {
+	switch t.typ {
+	case tokEOF:
+		return "end of expression"
+	case tokName:
+		return fmt.Sprintf("tag name %q", t.str)
+	case tokString:
+		return fmt.Sprintf("string %q", t.str)
+	default:
+		return fmt.Sprintf("%q", t.str)
+	}
+}
+
+// is returns true if the token is the keyword.
+func (t token) is(kw string) bool { return t.typ == tokKeyword && t.str == kw }
+
+// Parse parses the filter expression. Returns [SyntaxError] if the
+// expression is malformed.
+func Parse(query string) (Expr, error) {
+	p := &parser{query: query}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.typ != tokEOF {
+		return nil, p.unexpected()
+	}
+	return expr, nil
+}
+
+// MustParse is like [Parse] but panics on error.
+func MustParse(query string) Expr {
+	expr, err := Parse(query)
+	if err != nil {
+		panic(err)
+	}
+	return expr
+}
+
+// parser is the recursive descent parser of filter expressions.
+type parser struct {
+	query string // The expression.
+	pos   int    // Current position in the expression.
+	tok   token  // Current token.
+}
+
+// parseOr parses the logical disjunction.
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.is(kwOr) {
+		if err = p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &Or{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+// parseAnd parses the logical conjunction.
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.is(kwAnd) {
+		if err = p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &And{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+// parseNot parses the logical negation.
+func (p *parser) parseNot() (Expr, error) {
+	if !p.tok.is(kwNot) {
+		return p.parsePrimary()
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	return &Not{Expr: expr}, nil
+}
+
+// parsePrimary parses the expression in parentheses, the existence check,
+// or the tag condition.
+func (p *parser) parsePrimary() (Expr, error) {
+	switch {
+	case p.tok.typ == tokLParen:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.typ != tokRParen {
+			return nil, p.expected(`")"`)
+		}
+		return expr, p.next()
+
+	case p.tok.is(kwExists):
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if p.tok.typ != tokName {
+			return nil, p.expected("tag name")
+		}
+		name := p.tok.str
+		return &Exists{Name: name}, p.next()
+
+	case p.tok.typ == tokName:
+		return p.parseCondition()
+	}
+	return nil, p.expected("tag name")
+}
+
+// parseCondition parses the tag condition.
+func (p *parser) parseCondition() (Expr, error) {
+	name := p.tok.str
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case p.tok.typ == tokOp:
+		op := p.tok.val.(Op) // nolint: forcetypeassert
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		val, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		return &Compare{Name: name, Op: op, Value: val}, nil
+
+	case p.tok.is(kwIn):
+		return p.parseIn(name)
+
+	case p.tok.is(kwNot):
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if !p.tok.is(kwIn) {
+			return nil, p.expected(kwIn)
+		}
+		expr, err := p.parseIn(name)
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: expr}, nil
+
+	case p.tok.is(kwContains):
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		val, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		return &Contains{Name: name, Value: val}, nil
+	}
+	return nil, p.expected("operator")
+}
+
+// parseIn parses the list of values of the IN operator. The current token
+// must be the IN keyword.
+func (p *parser) parseIn(name string) (Expr, error) {
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if p.tok.typ != tokLParen {
+		return nil, p.expected(`"("`)
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	var vals []any
+	for {
+		val, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+		if p.tok.typ == tokRParen {
+			return &In{Name: name, Values: vals}, p.next()
+		}
+		if p.tok.typ != tokComma {
+			return nil, p.expected(`"," or ")"`)
+		}
+		if err = p.next(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseValue parses the literal value.
+func (p *parser) parseValue() (any, error) {
+	var val any
+	switch {
+	case p.tok.typ == tokString, p.tok.typ == tokNumber:
+		val = p.tok.val
+	case p.tok.is(kwTrue):
+		val = true
+	case p.tok.is(kwFalse):
+		val = false
+	default:
+		return nil, p.expected("value")
+	}
+	return val, p.next()
+}
+
+// expected returns the error reporting the current token is not what was
+// expected.
+func (p *parser) expected(what string) error {
+	msg := fmt.Sprintf("expected %s, got %s", what, p.tok.describe())
+	return &SyntaxError{Pos: p.tok.pos, Msg: msg}
+}
+
+// unexpected returns the error reporting the current token is unexpected.
+func (p *parser) unexpected() error {
+	msg := fmt.Sprintf("unexpected %s", p.tok.describe())
+	return &SyntaxError{Pos: p.tok.pos, Msg: msg}
+}
+
+// next scans the next token.
+//
+// nolint: cyclop
+func (p *parser) next() error {
+	for p.pos < len(p.query) {
+		r, size := utf8.DecodeRuneInString(p.query[p.pos:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		p.pos += size
+	}
+
+	start := p.pos
+	if start == len(p.query) {
+		p.tok = token{typ: tokEOF, pos: start}
+		return nil
+	}
+
+	r, size := utf8.DecodeRuneInString(p.query[start:])
+	switch {
+	case r == '(':
+		p.tok = token{typ: tokLParen, pos: start, str: "("}
+		p.pos++
+	case r == ')':
+		p.tok = token{typ: tokRParen, pos: start, str: ")"}
+		p.pos++
+	case r == ',':
+		p.tok = token{typ: tokComma, pos: start, str: ","}
+		p.pos++
+	case strings.ContainsRune("=!<>", r):
+		return p.scanOp()
+	case r == '"':
+		return p.scanString()
+	case r == '`':
+		return p.scanQuotedName()
+	case r == '-' || r == '+' || r == '.' || unicode.IsDigit(r):
+		return p.scanNumber()
+	case isNameRune(r, true):
+		p.scanName()
+	default:
+		p.pos += size
+		msg := fmt.Sprintf("unexpected character %q", r)
+		return &SyntaxError{Pos: start, Msg: msg}
+	}
+	return nil
+}
+
+// scanOp scans the comparison operator.
+func (p *parser) scanOp() error {
+	start := p.pos
+	for _, op := range []Op{OpNe, OpLe, OpGe, OpEq, OpLt, OpGt} {
+		if strings.HasPrefix(p.query[start:], op.String()) {
+			str := op.String()
+			p.tok = token{typ: tokOp, pos: start, str: str, val: op}
+			p.pos += len(str)
+			return nil
+		}
+	}
+	msg := fmt.Sprintf("unexpected character %q", p.query[start])
+	return &SyntaxError{Pos: start, Msg: msg}
+}
+
+// scanString scans the string literal in double quotes. Escape sequences are
+// the same as in Go.
+func (p *parser) scanString() error {
+	start := p.pos
+	end := start + 1
+	for ; end < len(p.query); end++ {
+		if p.query[end] == '\\' {
+			end++
+			continue
+		}
+		if p.query[end] == '"' {
+			break
+		}
+	}
+	if end >= len(p.query) {
+		return &SyntaxError{Pos: start, Msg: "unterminated string"}
+	}
+	raw := p.query[start : end+1]
+	str, err := strconv.Unquote(raw)
+	if err != nil {
+		msg := fmt.Sprintf("invalid string %s", raw)
+		return &SyntaxError{Pos: start, Msg: msg}
+	}
+	p.tok = token{typ: tokString, pos: start, str: str, val: str}
+	p.pos = end + 1
+	return nil
+}
+
+// scanQuotedName scans the tag name in backticks. The backtick in the name is
+// escaped by doubling it.
+func (p *parser) scanQuotedName() error {
+	start := p.pos
+	var name strings.Builder
+	for end := start + 1; end < len(p.query); end++ {
+		if p.query[end] != '`' {
+			name.WriteByte(p.query[end])
+			continue
+		}
+		if end+1 < len(p.query) && p.query[end+1] == '`' {
+			name.WriteByte('`')
+			end++
+			continue
+		}
+		p.tok = token{typ: tokName, pos: start, str: name.String()}
+		p.pos = end + 1
+		return nil
+	}
+	return &SyntaxError{Pos: start, Msg: "unterminated tag name"}
+}
+
+// scanNumber scans the integer or float number literal.
+func (p *parser) scanNumber() error {
+	start := p.pos
+	end := start + 1
+	for ; end < len(p.query); end++ {
+		c := p.query[end]
+		if (c == '-' || c == '+') &&
+			(p.query[end-1] == 'e' || p.query[end-1] == 'E') {
+			continue
+		}
+		if c != '.' && c != '_' && !unicode.IsLetter(rune(c)) &&
+			!unicode.IsDigit(rune(c)) {
+			break
+		}
+	}
+	str := p.query[start:end]
+	p.pos = end
+	if v, err := strconv.ParseInt(str, 10, 64); err == nil {
+		p.tok = token{typ: tokNumber, pos: start, str: str, val: v}
+		return nil
+	}
+	v, err := strconv.ParseFloat(str, 64)
+	if err != nil || strings.ContainsFunc(str, isNotExponentLetter) {
+		msg := fmt.Sprintf("invalid number %q", str)
+		return &SyntaxError{Pos: start, Msg: msg}
+	}
+	p.tok = token{typ: tokNumber, pos: start, str: str, val: v}
+	return nil
+}
+
+// isNotExponentLetter returns true if the rune is a letter other than the
+// exponent marker in float literals.
+func isNotExponentLetter(r rune) bool {
+	return unicode.IsLetter(r) && r != 'e' && r != 'E'
+}
+
+// scanName scans the bare tag name or the keyword.
+func (p *parser) scanName() {
+	start := p.pos
+	end := start
+	for end < len(p.query) {
+		r, size := utf8.DecodeRuneInString(p.query[end:])
+		if !isNameRune(r, end == start) {
+			break
+		}
+		end += size
+	}
+	str := p.query[start:end]
+	p.pos = end
+	if isKeyword(str) {
+		p.tok = token{typ: tokKeyword, pos: start, str: strings.ToUpper(str)}
+		return
+	}
+	p.tok = token{typ: tokName, pos: start, str: str}
+}
@@ -0,0 +1,355 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package filter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_Parse(t *testing.T) {
+	t.Run("comparison", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse(`env = "prod"`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := &Compare{Name: "env", Op: OpEq, Value: "prod"}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("precedence", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse(`a = 1 OR NOT b = 2 AND EXISTS c`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		b2 := &Compare{Name: "b", Op: OpEq, Value: int64(2)}
+		exp := &Or{
+			Left: &Compare{Name: "a", Op: OpEq, Value: int64(1)},
+			Right: &And{
+				Left:  &Not{Expr: b2},
+				Right: &Exists{Name: "c"},
+			},
+		}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("parentheses", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse(`(a = 1 OR b = 2) AND c = 3`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := &And{
+			Left: &Or{
+				Left:  &Compare{Name: "a", Op: OpEq, Value: int64(1)},
+				Right: &Compare{Name: "b", Op: OpEq, Value: int64(2)},
+			},
+			Right: &Compare{Name: "c", Op: OpEq, Value: int64(3)},
+		}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("left associative", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse(`EXISTS a AND EXISTS b AND EXISTS c`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := &And{
+			Left:  &And{Left: &Exists{Name: "a"}, Right: &Exists{Name: "b"}},
+			Right: &Exists{Name: "c"},
+		}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("in", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse(`region IN ("eu", "us")`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := &In{Name: "region", Values: []any{"eu", "us"}}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("not in", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse(`n NOT IN (1, 2.5, true)`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := &Not{Expr: &In{Name: "n", Values: []any{int64(1), 2.5, true}}}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("contains", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse(`zones contains "a"`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := &Contains{Name: "zones", Value: "a"}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("case-insensitive keywords", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse(`not exists a or b = False`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := &Or{
+			Left:  &Not{Expr: &Exists{Name: "a"}},
+			Right: &Compare{Name: "b", Op: OpEq, Value: false},
+		}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("quoted name", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse("`my tag`>=-1e3")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := &Compare{Name: "my tag", Op: OpGe, Value: -1e3}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("quoted name with backticks", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse("`a``b``` = 1")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := &Compare{Name: "a`b`", Op: OpEq, Value: int64(1)}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("string escapes", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse(`a != "x\"y\n"`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := &Compare{Name: "a", Op: OpNe, Value: "x\"y\n"}
+		assert.Equal(t, exp, have)
+	})
+}
+
+func Test_Parse_operators_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		query string
+		exp   Op
+	}{
+		{"eq", "a=1", OpEq},
+		{"ne", "a!=1", OpNe},
+		{"lt", "a<1", OpLt},
+		{"le", "a<=1", OpLe},
+		{"gt", "a>1", OpGt},
+		{"ge", "a>=1", OpGe},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := Parse(tc.query)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			exp := &Compare{Name: "a", Op: tc.exp, Value: int64(1)}
+			assert.Equal(t, exp, have)
+		})
+	}
+}
+
+func Test_Parse_names_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		query string
+		exp   string
+	}{
+		{"underscore", "_a = 1", "_a"},
+		{"dots and slash", "app.io/tier-x = 1", "app.io/tier-x"},
+		{"colon", "a:b = 1", "a:b"},
+		{"unicode", "zażółć = 1", "zażółć"},
+		{"quoted keyword", "`and` = 1", "and"},
+		{"quoted empty", "`` = 1", ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := Parse(tc.query)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			exp := &Compare{Name: tc.exp, Op: OpEq, Value: int64(1)}
+			assert.Equal(t, exp, have)
+		})
+	}
+}
+
+func Test_Parse_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		query string
+		exp   string
+	}{
+		{
+			"empty",
+			"",
+			"syntax error at position 0: " +
+				"expected tag name, got end of expression",
+		},
+		{
+			"missing operator",
+			"a",
+			"syntax error at position 1: " +
+				"expected operator, got end of expression",
+		},
+		{
+			"missing value",
+			"a = ",
+			"syntax error at position 4: " +
+				"expected value, got end of expression",
+		},
+		{
+			"name as value",
+			"a = b",
+			`syntax error at position 4: expected value, got tag name "b"`,
+		},
+		{
+			"unexpected character",
+			"a = 1 # b",
+			`syntax error at position 6: unexpected character '#'`,
+		},
+		{
+			"single bang",
+			"a ! 1",
+			`syntax error at position 2: unexpected character '!'`,
+		},
+		{
+			"unterminated string",
+			`a = "abc`,
+			"syntax error at position 4: unterminated string",
+		},
+		{
+			"invalid string",
+			`a = "\q"`,
+			`syntax error at position 4: invalid string "\q"`,
+		},
+		{
+			"unterminated name",
+			"`abc = 1",
+			"syntax error at position 0: unterminated tag name",
+		},
+		{
+			"invalid number",
+			"a = 1x",
+			`syntax error at position 4: invalid number "1x"`,
+		},
+		{
+			"infinity",
+			"a = +Inf",
+			`syntax error at position 4: invalid number "+Inf"`,
+		},
+		{
+			"not closed parenthesis",
+			"(a = 1",
+			`syntax error at position 6: ` +
+				`expected ")", got end of expression`,
+		},
+		{
+			"trailing token",
+			"a = 1)",
+			`syntax error at position 5: unexpected ")"`,
+		},
+		{
+			"missing AND",
+			"a = 1 b = 2",
+			`syntax error at position 6: unexpected tag name "b"`,
+		},
+		{
+			"exists without name",
+			"EXISTS 1",
+			`syntax error at position 7: expected tag name, got "1"`,
+		},
+		{
+			"NOT without IN",
+			"a NOT 1",
+			`syntax error at position 6: expected IN, got "1"`,
+		},
+		{
+			"IN without list",
+			"a IN 1",
+			`syntax error at position 5: expected "(", got "1"`,
+		},
+		{
+			"IN empty list",
+			"a IN ()",
+			`syntax error at position 6: expected value, got ")"`,
+		},
+		{
+			"IN missing comma",
+			`a IN (1 2)`,
+			`syntax error at position 8: expected "," or ")", got "2"`,
+		},
+		{
+			"CONTAINS without value",
+			`a CONTAINS AND`,
+			`syntax error at position 11: expected value, got "AND"`,
+		},
+		{
+			"dangling AND",
+			"a = 1 AND",
+			"syntax error at position 9: " +
+				"expected tag name, got end of expression",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := Parse(tc.query)
+
+			// --- Then ---
+			assert.ErrorIs(t, ErrSyntax, err)
+			assert.ErrorEqual(t, tc.exp, err)
+			assert.Nil(t, have)
+		})
+	}
+}
+
+func Test_Parse_SyntaxError(t *testing.T) {
+	// --- When ---
+	_, err := Parse(`a = 1 AND b ~ 2`)
+
+	// --- Then ---
+	var se *SyntaxError
+	assert.True(t, errors.As(err, &se))
+	assert.Equal(t, 12, se.Pos)
+	assert.Equal(t, `unexpected character '~'`, se.Msg)
+}
+
+func Test_MustParse(t *testing.T) {
+	// --- When ---
+	have := MustParse("EXISTS a")
+
+	// --- Then ---
+	assert.Equal(t, &Exists{Name: "a"}, have)
+}
+
+func Test_isKeyword(t *testing.T) {
+	assert.True(t, isKeyword("and"))
+	assert.True(t, isKeyword("Contains"))
+	assert.False(t, isKeyword("android"))
+}
@@ -6,6 +6,8 @@ package nomix
 import (
 	"fmt"
 	"strconv"
+
+	"github.com/ctx42/testing/pkg/must"
 )
 
 // TstIntSpec returns [KindSpec] used in testing. It is a very simple spec
@@ -18,11 +20,11 @@ func TstIntSpec() KindSpec {
 func TstIntCreate(name string, val any, opts ...Option) (Tag, error) {
 	switch v := val.(type) {
 	case int:
-		return NewSingle(name, v, KindInt, strconv.Itoa, nil), nil
+		return NewSingle(name, v, KindInt, strconv.Itoa, nil, nil), nil
 	case string:
 		def := NewOptions(opts...)
 		if def.Radix == 16 && v == "AA" {
-			return NewSingle(name, 170, KindInt, strconv.Itoa, nil), nil
+			return NewSingle(name, 170, KindInt, strconv.Itoa, nil, nil), nil
 		}
 		return nil, ErrInvFormat
 	default:
@@ -37,7 +39,18 @@ func TstIntParse(name, val string, opts ...Option) (Tag, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", name, ErrInvFormat)
 	}
-	return NewSingle(name, int(v), KindInt, strconv.Itoa, nil), nil
+	return NewSingle(name, int(v), KindInt, strconv.Itoa, nil, nil), nil
+}
+
+// TstRegistry returns the [Registry] used in testing. It has specs for the
+// [KindInt] and the [KindString], associated with the int and string types.
+func TstRegistry() *Registry {
+	reg := NewRegistry()
+	must.Nil(reg.Register(TstIntSpec()))
+	must.Nil(reg.Register(tstStrSpec()))
+	must.Value(reg.Associate(0, KindInt))
+	must.Value(reg.Associate("", KindString))
+	return reg
 }
 
 // TstRule implements [verax.Rule] interface for use in testing.
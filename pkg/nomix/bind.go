@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// StructTagKey is the struct field tag key used by [Encode] and [Decode].
+const StructTagKey = "nomix"
+
+// structField represents a struct field bound to a tag.
+type structField struct {
+	name      string // Tag name.
+	index     []int  // Field index sequence.
+	omitEmpty bool   // Skip the field with zero value when encoding.
+}
+
+// Encode creates a [TagSet] from the exported fields of the struct, or the
+// pointer to the struct. Tags are created with the [Registry.Create] method,
+// so the field types must be associated with [KindSpec] instances in the
+// registry. When the registry is nil, the [GlobalRegistry] is used.
+//
+// The field tag "nomix" sets the tag name and options:
+//
+//	ID    int    `nomix:"id"`             // Tag named "id".
+//	Env   string `nomix:"env,omitempty"`  // Skipped when empty.
+//	Skip  string `nomix:"-"`              // Always skipped.
+//	Owner string                          // Tag named "Owner".
+//
+// Fields of embedded structs without the tag name are encoded as if they
+// were fields of the outer struct. Nil pointer fields are skipped, otherwise
+// the values they point to are encoded.
+//
+// Returns an error wrapping [ErrInvType] if the value is not a struct, or
+// [FieldErrors] with errors by tag names if any of the tags cannot be
+// created.
+func Encode(v any, reg *Registry) (TagSet, error) {
+	if reg == nil {
+		reg = GlobalRegistry()
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		const format = "%w: expected struct, got %T"
+		return TagSet{}, fmt.Errorf(format, ErrInvType, v)
+	}
+
+	flds := structFields(rv.Type(), nil)
+	set := NewTagSet(WithLen(len(flds)))
+	errs := make(map[string]error)
+	for _, fld := range flds {
+		fv := rv.FieldByIndex(fld.index)
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		if fld.omitEmpty && fv.IsZero() {
+			continue
+		}
+		tag, err := reg.Create(fld.name, fv.Interface())
+		if err != nil {
+			errs[fld.name] = err
+			continue
+		}
+		set.TagSet(tag)
+	}
+	if len(errs) > 0 {
+		return TagSet{}, NewFieldErrors(errs)
+	}
+	return set, nil
+}
+
+// Decode sets the exported fields of the struct the pointer points to with
+// values of the tags from the set. Fields are bound to tags the same way as
+// in [Encode]. Fields without tags in the set are left unchanged. Pointer
+// fields are set to newly allocated values.
+//
+// The tag value must be assignable to the field type or, when both are of
+// the same [reflect.Kind], convertible to it. For example, the [KindString]
+// tag value can be assigned to a field of a named string type. Integers and
+// floats, also as slice elements, are converted to other integer and float
+// types, so the int64 value of the [KindInt64] tag can be assigned to an
+// int8 field, as long as it doesn't overflow it.
+//
+// Returns an error wrapping [ErrInvType] if the value is not a non-nil
+// pointer to a struct, or [FieldErrors] with errors by tag names if any of
+// the tag values cannot be assigned, wrapping [ErrInvValue] for overflows.
+func Decode(set TagSet, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() ||
+		rv.Elem().Kind() != reflect.Struct {
+
+		const format = "%w: expected non-nil pointer to struct, got %T"
+		return fmt.Errorf(format, ErrInvType, v)
+	}
+	rv = rv.Elem()
+
+	errs := make(map[string]error)
+	for _, fld := range structFields(rv.Type(), nil) {
+		tag := set.TagGet(fld.name)
+		if tag == nil {
+			continue
+		}
+		fv := rv.FieldByIndex(fld.index)
+		if err := setField(fv, tag.TagValue()); err != nil {
+			errs[fld.name] = err
+		}
+	}
+	if len(errs) > 0 {
+		return NewFieldErrors(errs)
+	}
+	return nil
+}
+
+// structFields returns fields of the struct type bound to tags. The index is
+// the index sequence of the struct type in the outer struct, nil for the
+// outermost one.
+func structFields(rt reflect.Type, index []int) []structField {
+	var flds []structField
+	for i := range rt.NumField() {
+		sf := rt.Field(i)
+		val := sf.Tag.Get(StructTagKey)
+		if val == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(val, ",")
+		idx := append(slices.Clip(index), i)
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct {
+			flds = append(flds, structFields(sf.Type, idx)...)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		fld := structField{
+			name:      name,
+			index:     idx,
+			omitEmpty: slices.Contains(strings.Split(opts, ","), "omitempty"),
+		}
+		flds = append(flds, fld)
+	}
+	return flds
+}
+
+// setField sets the struct field to the value.
+func setField(fv reflect.Value, val any) error {
+	ft := fv.Type()
+	if ft.Kind() == reflect.Pointer {
+		ft = ft.Elem()
+	}
+	rv, err := convertValue(reflect.ValueOf(val), ft)
+	if err != nil {
+		return err
+	}
+	if fv.Kind() == reflect.Pointer {
+		ptr := reflect.New(ft)
+		ptr.Elem().Set(rv)
+		rv = ptr
+	}
+	fv.Set(rv)
+	return nil
+}
+
+// convertValue converts the value to the type. Integers and floats are
+// converted to other integer and float types, also when they are slice
+// elements. Returns an error wrapping [ErrInvValue] if the value overflows
+// the type, or [ErrInvType] if it cannot be converted.
+//
+// nolint: cyclop
+func convertValue(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+	switch {
+	case rv.Type().AssignableTo(rt):
+		return rv, nil
+
+	case rv.Kind() == rt.Kind() && rv.Type().ConvertibleTo(rt):
+		return rv.Convert(rt), nil
+
+	case isIntKind(rv.Kind()) && isIntKind(rt.Kind()):
+		if intOverflows(rv, rt) {
+			const format = "%w: %v overflows %s"
+			return reflect.Value{}, fmt.Errorf(format, ErrInvValue, rv, rt)
+		}
+		return rv.Convert(rt), nil
+
+	case isFloatKind(rv.Kind()) && isFloatKind(rt.Kind()):
+		if reflect.Zero(rt).OverflowFloat(rv.Float()) {
+			const format = "%w: %v overflows %s"
+			return reflect.Value{}, fmt.Errorf(format, ErrInvValue, rv, rt)
+		}
+		return rv.Convert(rt), nil
+
+	case rv.Kind() == reflect.Slice && rt.Kind() == reflect.Slice:
+		if rv.IsNil() {
+			return reflect.Zero(rt), nil
+		}
+		out := reflect.MakeSlice(rt, rv.Len(), rv.Len())
+		for i := range rv.Len() {
+			ev, err := convertValue(rv.Index(i), rt.Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			out.Index(i).Set(ev)
+		}
+		return out, nil
+	}
+	const format = "%w: %s cannot be assigned to %s"
+	return reflect.Value{}, fmt.Errorf(format, ErrInvType, rv.Type(), rt)
+}
+
+// isIntKind returns true for signed and unsigned integer kinds.
+func isIntKind(knd reflect.Kind) bool {
+	return knd >= reflect.Int && knd <= reflect.Uint64
+}
+
+// isFloatKind returns true for float kinds.
+func isFloatKind(knd reflect.Kind) bool {
+	return knd == reflect.Float32 || knd == reflect.Float64
+}
+
+// intOverflows returns true if the integer value cannot be represented by
+// the integer type.
+func intOverflows(rv reflect.Value, rt reflect.Type) bool {
+	zero := reflect.Zero(rt)
+	signed := rt.Kind() <= reflect.Int64
+	if rv.Kind() <= reflect.Int64 {
+		v := rv.Int()
+		if signed {
+			return zero.OverflowInt(v)
+		}
+		return v < 0 || zero.OverflowUint(uint64(v))
+	}
+	v := rv.Uint()
+	if signed {
+		return v > math.MaxInt64 || zero.OverflowInt(int64(v))
+	}
+	return zero.OverflowUint(v)
+}
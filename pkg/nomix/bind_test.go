@@ -0,0 +1,387 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+// tstBindWideRegistry returns the [TstRegistry] with specs for the 64-bit
+// kinds associated with narrower types, like the xtag package does.
+func tstBindWideRegistry() *Registry {
+	i64 := func(name string, val any, _ ...Option) (Tag, error) {
+		v, err := CreateInt64(val)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, ErrInvType)
+		}
+		return NewSingle(name, v, KindInt64, nil, nil, nil), nil
+	}
+	f64 := func(name string, val any, _ ...Option) (Tag, error) {
+		v, err := CreateFloat64(val)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, ErrInvType)
+		}
+		return NewSingle(name, v, KindFloat64, nil, nil, nil), nil
+	}
+	i64s := func(name string, val any, _ ...Option) (Tag, error) {
+		v, err := CreateInt64Slice(val)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, ErrInvType)
+		}
+		return NewSlice(name, v, KindInt64Slice, nil, nil, nil), nil
+	}
+	reg := TstRegistry()
+	must.Nil(reg.Register(NewKindSpec(KindInt64, i64, nil)))
+	must.Nil(reg.Register(NewKindSpec(KindFloat64, f64, nil)))
+	must.Nil(reg.Register(NewKindSpec(KindInt64Slice, i64s, nil)))
+	must.Value(reg.Associate(int8(0), KindInt64))
+	must.Value(reg.Associate(int32(0), KindInt64))
+	must.Value(reg.Associate(uint8(0), KindInt64))
+	must.Value(reg.Associate(float32(0), KindFloat64))
+	must.Value(reg.Associate([]int16{}, KindInt64Slice))
+	return reg
+}
+
+// tstBindWide is a struct with fields of types stored as 64-bit kinds.
+type tstBindWide struct {
+	I8  int8    `nomix:"i8"`
+	I32 int32   `nomix:"i32"`
+	U8  uint8   `nomix:"u8"`
+	F32 float32 `nomix:"f32"`
+	I16 []int16 `nomix:"i16"`
+}
+
+// tstEnv is a named string type used in struct binding tests.
+type tstEnv string
+
+// tstBindBase is a struct embedded in [tstBindStruct].
+type tstBindBase struct {
+	ID int `nomix:"id"`
+}
+
+// tstBindStruct is a struct used in struct binding tests.
+type tstBindStruct struct {
+	tstBindBase
+	Env      string  `nomix:"env,omitempty"`
+	Replicas *int    `nomix:"replicas"`
+	Owner    string  // Tag name from the field name.
+	Skip     string  `nomix:"-"`
+	Dash     string  `nomix:"-,"`
+	Ptr      *string `nomix:"ptr,omitempty"`
+	private  string
+}
+
+func Test_Encode(t *testing.T) {
+	t.Run("struct", func(t *testing.T) {
+		// --- Given ---
+		replicas := 3
+		v := tstBindStruct{
+			tstBindBase: tstBindBase{ID: 1},
+			Env:         "prod",
+			Replicas:    &replicas,
+			Owner:       "ops",
+			Skip:        "skip",
+			Dash:        "dash",
+			private:     "private",
+		}
+
+		// --- When ---
+		have, err := Encode(v, TstRegistry())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := map[string]any{
+			"id":       1,
+			"env":      "prod",
+			"replicas": 3,
+			"Owner":    "ops",
+			"-":        "dash",
+		}
+		assert.Equal(t, exp, have.MetaGetAll())
+	})
+
+	t.Run("pointer to struct", func(t *testing.T) {
+		// --- Given ---
+		v := &tstBindBase{ID: 1}
+
+		// --- When ---
+		have, err := Encode(v, TstRegistry())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"id": 1}, have.MetaGetAll())
+	})
+
+	t.Run("omit empty and nil pointers", func(t *testing.T) {
+		// --- Given ---
+		v := tstBindStruct{}
+
+		// --- When ---
+		have, err := Encode(v, TstRegistry())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := map[string]any{"id": 0, "Owner": "", "-": ""}
+		assert.Equal(t, exp, have.MetaGetAll())
+	})
+
+	t.Run("global registry", func(t *testing.T) {
+		// --- Given ---
+		v := struct{}{}
+
+		// --- When ---
+		have, err := Encode(v, nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 0, have.TagCount())
+	})
+
+	t.Run("error - not a struct", func(t *testing.T) {
+		// --- When ---
+		have, err := Encode(1, TstRegistry())
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		wMsg := "invalid element type: expected struct, got int"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Equal(t, 0, have.TagCount())
+	})
+
+	t.Run("error - nil pointer", func(t *testing.T) {
+		// --- Given ---
+		var v *tstBindBase
+
+		// --- When ---
+		have, err := Encode(v, TstRegistry())
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Equal(t, 0, have.TagCount())
+	})
+
+	t.Run("error - fields", func(t *testing.T) {
+		// --- Given ---
+		v := struct {
+			ID  int     `nomix:"id"`
+			Env tstEnv  `nomix:"env"`
+			Cpu float64 `nomix:"cpu"`
+		}{}
+
+		// --- When ---
+		have, err := Encode(v, TstRegistry())
+
+		// --- Then ---
+		assert.Equal(t, 0, have.TagCount())
+		errs := tstFieldErrors(t, err)
+		assert.Len(t, 2, errs)
+		assert.ErrorIs(t, ErrNoCreator, errs["env"])
+		assert.ErrorIs(t, ErrNoCreator, errs["cpu"])
+	})
+}
+
+func Test_Decode(t *testing.T) {
+	t.Run("struct", func(t *testing.T) {
+		// --- Given ---
+		set := tstSet(
+			tstInt("id", 1),
+			tstStr("env", "prod"),
+			tstInt("replicas", 3),
+			tstStr("Owner", "ops"),
+			tstStr("Skip", "skip"),
+			tstStr("-", "dash"),
+			tstStr("private", "private"),
+		)
+		v := &tstBindStruct{}
+
+		// --- When ---
+		err := Decode(set, v)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 1, v.ID)
+		assert.Equal(t, "prod", v.Env)
+		assert.Equal(t, 3, *v.Replicas)
+		assert.Equal(t, "ops", v.Owner)
+		assert.Equal(t, "", v.Skip)
+		assert.Equal(t, "dash", v.Dash)
+		assert.Nil(t, v.Ptr)
+		assert.Equal(t, "", v.private)
+	})
+
+	t.Run("missing tags leave fields unchanged", func(t *testing.T) {
+		// --- Given ---
+		v := &tstBindStruct{Env: "qa"}
+
+		// --- When ---
+		err := Decode(tstSet(tstInt("id", 1)), v)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 1, v.ID)
+		assert.Equal(t, "qa", v.Env)
+	})
+
+	t.Run("convertible type", func(t *testing.T) {
+		// --- Given ---
+		v := &struct {
+			Env tstEnv `nomix:"env"`
+		}{}
+
+		// --- When ---
+		err := Decode(tstSet(tstStr("env", "prod")), v)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, tstEnv("prod"), v.Env)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		replicas := 3
+		src := tstBindStruct{
+			tstBindBase: tstBindBase{ID: 1},
+			Env:         "prod",
+			Replicas:    &replicas,
+		}
+		set := must.Value(Encode(src, TstRegistry()))
+		dst := &tstBindStruct{}
+
+		// --- When ---
+		err := Decode(set, dst)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, src, *dst)
+	})
+
+	t.Run("round trip narrow numbers", func(t *testing.T) {
+		// --- Given ---
+		src := tstBindWide{
+			I8:  -8,
+			I32: 32,
+			U8:  255,
+			F32: 1.5,
+			I16: []int16{-16, 16},
+		}
+		set := must.Value(Encode(src, tstBindWideRegistry()))
+		dst := &tstBindWide{}
+
+		// --- When ---
+		err := Decode(set, dst)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, src, *dst)
+		assert.Equal(t, KindInt64, set.TagGet("i8").TagKind())
+		assert.Equal(t, KindInt64Slice, set.TagGet("i16").TagKind())
+	})
+
+	t.Run("number conversions", func(t *testing.T) {
+		// --- Given ---
+		set := tstSet(
+			NewSingle("i8", int64(-8), KindInt64, nil, nil, nil),
+			NewSingle("u8", int64(8), KindInt64, nil, nil, nil),
+			NewSingle("f32", 2.5, KindFloat64, nil, nil, nil),
+			NewSlice("i16", []int64{1, 2}, KindInt64Slice, nil, nil, nil),
+		)
+		v := &tstBindWide{}
+
+		// --- When ---
+		err := Decode(set, v)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := tstBindWide{I8: -8, U8: 8, F32: 2.5, I16: []int16{1, 2}}
+		assert.Equal(t, want, *v)
+	})
+
+	t.Run("error - number overflows", func(t *testing.T) {
+		// --- Given ---
+		set := tstSet(
+			NewSingle("i8", int64(128), KindInt64, nil, nil, nil),
+			NewSingle("u8", int64(-1), KindInt64, nil, nil, nil),
+			NewSingle("f32", 1e300, KindFloat64, nil, nil, nil),
+			NewSlice("i16", []int64{1, 1 << 20}, KindInt64Slice, nil, nil, nil),
+		)
+		v := &tstBindWide{}
+
+		// --- When ---
+		err := Decode(set, v)
+
+		// --- Then ---
+		errs := tstFieldErrors(t, err)
+		assert.Len(t, 4, errs)
+		assert.ErrorIs(t, ErrInvValue, errs["i8"])
+		wMsg := "invalid element value: 128 overflows int8"
+		assert.ErrorEqual(t, wMsg, errs["i8"])
+		assert.ErrorIs(t, ErrInvValue, errs["u8"])
+		assert.ErrorIs(t, ErrInvValue, errs["f32"])
+		assert.ErrorIs(t, ErrInvValue, errs["i16"])
+		assert.Equal(t, tstBindWide{}, *v)
+	})
+
+	t.Run("error - not a pointer", func(t *testing.T) {
+		// --- When ---
+		err := Decode(tstSet(), tstBindBase{})
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		wMsg := "invalid element type: expected non-nil pointer to struct, " +
+			"got nomix.tstBindBase"
+		assert.ErrorEqual(t, wMsg, err)
+	})
+
+	t.Run("error - nil pointer", func(t *testing.T) {
+		// --- Given ---
+		var v *tstBindBase
+
+		// --- When ---
+		err := Decode(tstSet(), v)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+	})
+
+	t.Run("error - pointer to not a struct", func(t *testing.T) {
+		// --- Given ---
+		v := 1
+
+		// --- When ---
+		err := Decode(tstSet(), &v)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+	})
+
+	t.Run("error - fields", func(t *testing.T) {
+		// --- Given ---
+		set := tstSet(
+			tstStr("id", "1"),
+			tstInt("env", 1),
+			tstStr("name", "abc"),
+		)
+		v := &struct {
+			ID   int    `nomix:"id"`
+			Env  string `nomix:"env"`
+			Name string `nomix:"name"`
+		}{}
+
+		// --- When ---
+		err := Decode(set, v)
+
+		// --- Then ---
+		errs := tstFieldErrors(t, err)
+		assert.Len(t, 2, errs)
+		assert.ErrorIs(t, ErrInvType, errs["id"])
+		wMsg := "invalid element type: string cannot be assigned to int"
+		assert.ErrorEqual(t, wMsg, errs["id"])
+		assert.ErrorIs(t, ErrInvType, errs["env"])
+		assert.Equal(t, "abc", v.Name)
+	})
+}
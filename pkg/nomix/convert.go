@@ -0,0 +1,282 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// BytesFormat represents the metadata representation of byte slices.
+type BytesFormat uint8
+
+// Byte slice representations.
+const (
+	// BytesRaw represents byte slices as []byte.
+	BytesRaw BytesFormat = iota
+
+	// BytesBase64 represents byte slices as strings in the standard base64
+	// encoding.
+	BytesBase64
+
+	// BytesHex represents byte slices as hexadecimal strings.
+	BytesHex
+)
+
+// ToTagSet converts the metadata to tags. When the registry is nil, the
+// [GlobalRegistry] is used. The registry options are applied before the
+// passed options, both when coercing and when creating tags.
+//
+// Tags for keys with definitions, set by the [WithSchema] and
+// [WithDefinitions] options, are created with the definition's [KindSpec].
+// Their values are coerced to the kind the same way as by the [MetaSet] typed
+// getters, and byte slices may be represented as strings in the
+// [Options.BytesFormat]. Maps and slices for [KindJSON] definitions are
+// marshaled to JSON. The definition rules are not validated.
+//
+// Tags for other keys are created with the [KindSpec] for the value type.
+// Since JSON numbers are decoded as float64, floats without a fractional part
+// and integer [json.Number] values are widened to int, and []any slices with
+// elements of the same type are converted to typed slices.
+//
+// Returns [FieldErrors] with errors of the keys which values couldn't be
+// converted to tags.
+func ToTagSet(meta MetaSet, reg *Registry, opts ...Option) (TagSet, error) {
+	if reg == nil {
+		reg = GlobalRegistry()
+	}
+	ops := NewOptions(reg.options(opts)...)
+	set := NewTagSet(WithLen(len(meta.m)))
+	errs := make(map[string]error)
+	for key, val := range meta.m {
+		tag, err := metaTag(reg, key, val, opts, ops)
+		if err != nil {
+			errs[key] = err
+			continue
+		}
+		set.TagSet(tag)
+	}
+	if len(errs) > 0 {
+		return TagSet{}, NewFieldErrors(errs)
+	}
+	return set, nil
+}
+
+// ToMetaSet converts the tags to metadata. By default, tag values are used
+// as they are. With the [WithTimeString] option, time values are represented
+// as strings formatted with the [Options.TimeFormat], and with the
+// [WithBytesFormat] option, [KindByteSlice] values are represented as
+// strings. Values of [KindJSON] tags are always used as they are. Use the
+// same options and definitions of such tags to convert the metadata back
+// with [ToTagSet].
+func ToMetaSet(set TagSet, opts ...Option) MetaSet {
+	ops := NewOptions(opts...)
+	meta := NewMetaSet(WithLen(len(set.m)))
+	for name, tag := range set.m {
+		meta.MetaSet(name, metaValue(tag, ops))
+	}
+	return meta
+}
+
+// metaTag creates the named tag from the metadata value.
+func metaTag(
+	reg *Registry,
+	name string,
+	val any,
+	opts []Option,
+	ops Options,
+) (Tag, error) {
+
+	def := ops.defs[name]
+	if def == nil {
+		return reg.Create(name, widen(val), opts...)
+	}
+	v, err := coerceKind(val, def.TagKind(), ops)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return def.spec.TagCreate(name, v, reg.options(opts)...)
+}
+
+// coerceKind coerces the metadata value to the type of the kind values. Maps
+// and slices are marshaled for the [KindJSON]. Values of kinds without
+// coercion rules are returned unchanged.
+func coerceKind(val any, knd Kind, opts Options) (any, error) {
+	switch knd {
+	case KindString:
+		return anyOf(castString(val, opts))
+	case KindInt:
+		return anyOf(castInt(val, opts))
+	case KindInt64:
+		return anyOf(castInt64(val, opts))
+	case KindFloat64:
+		return anyOf(castFloat64(val, opts))
+	case KindBool:
+		return anyOf(castBool(val, opts))
+	case KindTime:
+		return anyOf(CreateTime(val, opts))
+	case KindByteSlice:
+		return anyOf(castBytes(val, opts))
+	case KindStringSlice:
+		return anyOf(castSlice(val, opts, castString))
+	case KindIntSlice:
+		return anyOf(castSlice(val, opts, castInt))
+	case KindInt64Slice:
+		return anyOf(castSlice(val, opts, castInt64))
+	case KindFloat64Slice:
+		return anyOf(castSlice(val, opts, castFloat64))
+	case KindBoolSlice:
+		return anyOf(castSlice(val, opts, castBool))
+	case KindTimeSlice:
+		return anyOf(castSlice(val, opts, CreateTime))
+	case KindJSON:
+		switch v := val.(type) {
+		case map[string]any, []any:
+			data, err := json.Marshal(v)
+			if err != nil {
+				return nil, ErrInvValue
+			}
+			return json.RawMessage(data), nil
+		}
+	}
+	return val, nil
+}
+
+// anyOf returns the value as any or the error.
+func anyOf[T any](val T, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+// widen converts numbers and slices decoded from JSON to the types matching
+// their values. Other values are returned unchanged.
+func widen(val any) any {
+	switch v := val.(type) {
+	case float64:
+		if i, err := castInt(v, Options{}); err == nil {
+			return i
+		}
+	case json.Number:
+		if i, err := castInt(v, Options{}); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+	case []any:
+		return widenSlice(v)
+	}
+	return val
+}
+
+// widenSlice converts the slice with elements of the same type, after
+// widening them, to the typed slice. Returns the slice unchanged otherwise.
+func widenSlice(vs []any) any {
+	if len(vs) == 0 {
+		return vs
+	}
+	var str, bol, num, flt int
+	es := make([]any, len(vs))
+	for i, v := range vs {
+		es[i] = widen(v)
+		switch es[i].(type) {
+		case string:
+			str++
+		case bool:
+			bol++
+		case int:
+			num++
+		case float64:
+			flt++
+		}
+	}
+
+	// Elements are known to be castable, so errors are not possible.
+	var ops Options
+	switch len(es) {
+	case str:
+		v, _ := castSlice(es, ops, castString)
+		return v
+	case bol:
+		v, _ := castSlice(es, ops, castBool)
+		return v
+	case num:
+		v, _ := castSlice(es, ops, castInt)
+		return v
+	case num + flt:
+		v, _ := castSlice(es, ops, castFloat64)
+		return v
+	}
+	return vs
+}
+
+// castBytes coerces the value to []byte. Strings are decoded according to
+// the [Options.BytesFormat].
+func castBytes(val any, opts Options) ([]byte, error) {
+	switch v := val.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		var b []byte
+		var err error
+		switch opts.BytesFormat {
+		case BytesBase64:
+			b, err = base64.StdEncoding.DecodeString(v)
+		case BytesHex:
+			b, err = hex.DecodeString(v)
+		default:
+			return nil, ErrInvType
+		}
+		if err != nil {
+			return nil, ErrInvFormat
+		}
+		return b, nil
+	}
+	return nil, ErrInvType
+}
+
+// metaValue returns the metadata representation of the tag value. Values
+// are represented according to the tag kind, so values of kinds without
+// representation options, like [KindJSON], are returned unchanged.
+func metaValue(tag Tag, opts Options) any {
+	val := tag.TagValue()
+	switch tag.TagKind() {
+	case KindTime:
+		if v, ok := val.(time.Time); ok && opts.TimeAsString {
+			return formatTime(v, opts)
+		}
+	case KindTimeSlice:
+		if v, ok := val.([]time.Time); ok && opts.TimeAsString {
+			strs := make([]string, len(v))
+			for i, tim := range v {
+				strs[i] = formatTime(tim, opts)
+			}
+			return strs
+		}
+	case KindByteSlice:
+		if v, ok := val.([]byte); ok {
+			switch opts.BytesFormat {
+			case BytesBase64:
+				return base64.StdEncoding.EncodeToString(v)
+			case BytesHex:
+				return hex.EncodeToString(v)
+			}
+		}
+	}
+	return val
+}
+
+// formatTime formats the time with the [Options.TimeFormat] in the
+// [Options.Location] when set.
+func formatTime(tim time.Time, opts Options) string {
+	if opts.Location != nil {
+		tim = tim.In(opts.Location)
+	}
+	return tim.Format(opts.TimeFormat)
+}
@@ -0,0 +1,513 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+// tstConvSpec returns the spec creating tags of the kind from T values.
+func tstConvSpec[T comparable](knd Kind) KindSpec {
+	create := func(name string, val any, _ ...Option) (Tag, error) {
+		if v, ok := val.(T); ok {
+			return NewSingle(name, v, knd, nil, nil, nil), nil
+		}
+		return nil, fmt.Errorf("%s: %w", name, ErrInvType)
+	}
+	return NewKindSpec(knd, create, nil)
+}
+
+// tstConvSliceSpec returns the spec creating tags of the kind from []T
+// values.
+func tstConvSliceSpec[T comparable](knd Kind) KindSpec {
+	create := func(name string, val any, _ ...Option) (Tag, error) {
+		if v, ok := val.([]T); ok {
+			return NewSlice(name, v, knd, nil, nil, nil), nil
+		}
+		return nil, fmt.Errorf("%s: %w", name, ErrInvType)
+	}
+	return NewKindSpec(knd, create, nil)
+}
+
+// tstConvJSONSpec returns the spec creating [KindJSON] tags from []byte and
+// [json.RawMessage] values.
+func tstConvJSONSpec() KindSpec {
+	create := func(name string, val any, _ ...Option) (Tag, error) {
+		switch v := val.(type) {
+		case []byte:
+			return NewSlice(name, v, KindJSON, nil, nil, nil), nil
+		case json.RawMessage:
+			return NewSlice(name, []byte(v), KindJSON, nil, nil, nil), nil
+		}
+		return nil, fmt.Errorf("%s: %w", name, ErrInvType)
+	}
+	return NewKindSpec(KindJSON, create, nil)
+}
+
+// tstConvRegistry returns the [TstRegistry] with specs for other kinds used
+// in conversion tests.
+func tstConvRegistry() *Registry {
+	reg := TstRegistry()
+	must.Nil(reg.Register(tstConvSpec[float64](KindFloat64)))
+	must.Nil(reg.Register(tstConvSpec[bool](KindBool)))
+	must.Nil(reg.Register(tstConvSpec[time.Time](KindTime)))
+	must.Nil(reg.Register(tstConvSliceSpec[byte](KindByteSlice)))
+	must.Nil(reg.Register(tstConvSliceSpec[int](KindIntSlice)))
+	must.Nil(reg.Register(tstConvSliceSpec[float64](KindFloat64Slice)))
+	must.Nil(reg.Register(tstConvSliceSpec[bool](KindBoolSlice)))
+	must.Nil(reg.Register(tstConvSliceSpec[string](KindStringSlice)))
+	must.Nil(reg.Register(tstConvSliceSpec[time.Time](KindTimeSlice)))
+	must.Nil(reg.Register(tstConvJSONSpec()))
+	must.Value(reg.Associate(0.0, KindFloat64))
+	must.Value(reg.Associate(true, KindBool))
+	must.Value(reg.Associate(time.Time{}, KindTime))
+	must.Value(reg.Associate([]byte{}, KindByteSlice))
+	must.Value(reg.Associate([]int{}, KindIntSlice))
+	must.Value(reg.Associate([]float64{}, KindFloat64Slice))
+	must.Value(reg.Associate([]bool{}, KindBoolSlice))
+	must.Value(reg.Associate([]string{}, KindStringSlice))
+	must.Value(reg.Associate([]time.Time{}, KindTimeSlice))
+	return reg
+}
+
+func Test_ToTagSet(t *testing.T) {
+	t.Run("kinds for value types", func(t *testing.T) {
+		// --- Given ---
+		tim := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		meta := NewMetaSet(WithMeta(map[string]any{
+			"A": 42,
+			"B": "b",
+			"C": true,
+			"D": tim,
+			"E": []byte{1, 2},
+		}))
+
+		// --- When ---
+		have, err := ToTagSet(meta, tstConvRegistry())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 5, have.TagCount())
+		assert.Equal(t, KindInt, have.TagGet("A").TagKind())
+		assert.Equal(t, KindString, have.TagGet("B").TagKind())
+		assert.Equal(t, KindBool, have.TagGet("C").TagKind())
+		assert.Equal(t, KindTime, have.TagGet("D").TagKind())
+		assert.Equal(t, KindByteSlice, have.TagGet("E").TagKind())
+		assert.Equal(t, meta.MetaGetAll(), have.MetaGetAll())
+	})
+
+	t.Run("numbers decoded from JSON", func(t *testing.T) {
+		// --- Given ---
+		meta := NewMetaSet(WithMeta(map[string]any{
+			"A": 42.0,
+			"B": 4.2,
+			"C": json.Number("42"),
+			"D": json.Number("4.2"),
+		}))
+
+		// --- When ---
+		have, err := ToTagSet(meta, tstConvRegistry())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, KindInt, have.TagGet("A").TagKind())
+		assert.Equal(t, KindFloat64, have.TagGet("B").TagKind())
+		assert.Equal(t, KindInt, have.TagGet("C").TagKind())
+		assert.Equal(t, KindFloat64, have.TagGet("D").TagKind())
+		want := map[string]any{"A": 42, "B": 4.2, "C": 42, "D": 4.2}
+		assert.Equal(t, want, have.MetaGetAll())
+	})
+
+	t.Run("slices decoded from JSON", func(t *testing.T) {
+		// --- Given ---
+		meta := NewMetaSet(WithMeta(map[string]any{
+			"A": []any{"a", "b"},
+			"B": []any{1.0, 2.0},
+			"C": []any{1.0, 2.5},
+			"D": []any{true, false},
+		}))
+
+		// --- When ---
+		have, err := ToTagSet(meta, tstConvRegistry())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := map[string]any{
+			"A": []string{"a", "b"},
+			"B": []int{1, 2},
+			"C": []float64{1, 2.5},
+			"D": []bool{true, false},
+		}
+		assert.Equal(t, want, have.MetaGetAll())
+	})
+
+	t.Run("kinds from definitions", func(t *testing.T) {
+		// --- Given ---
+		reg := tstConvRegistry()
+		defs := []*Definition{
+			Define("A", reg.SpecForKind(KindInt)),
+			Define("B", reg.SpecForKind(KindFloat64)),
+			Define("C", reg.SpecForKind(KindTime)),
+			Define("D", reg.SpecForKind(KindByteSlice)),
+			Define("E", reg.SpecForKind(KindTimeSlice)),
+			Define("F", reg.SpecForKind(KindIntSlice)),
+		}
+		meta := NewMetaSet(WithMeta(map[string]any{
+			"A": "42",
+			"B": 42.0,
+			"C": "2026-01-02T03:04:05Z",
+			"D": "AQI=",
+			"E": []any{"2026-01-02T03:04:05Z"},
+			"F": []any{},
+			"G": 42.0,
+		}))
+
+		// --- When ---
+		have, err := ToTagSet(
+			meta,
+			reg,
+			WithDefinitions(defs...),
+			WithBytesFormat(BytesBase64),
+		)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		tim := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		want := map[string]any{
+			"A": 42,
+			"B": 42.0,
+			"C": tim,
+			"D": []byte{1, 2},
+			"E": []time.Time{tim},
+			"F": []int{},
+			"G": 42,
+		}
+		assert.Equal(t, want, have.MetaGetAll())
+		assert.Equal(t, KindFloat64, have.TagGet("B").TagKind())
+	})
+
+	t.Run("json from definition", func(t *testing.T) {
+		// --- Given ---
+		reg := tstConvRegistry()
+		defs := []*Definition{
+			Define("A", reg.SpecForKind(KindJSON)),
+			Define("B", reg.SpecForKind(KindJSON)),
+			Define("C", reg.SpecForKind(KindJSON)),
+		}
+		meta := NewMetaSet(WithMeta(map[string]any{
+			"A": map[string]any{"a": 1.0},
+			"B": []any{1.0, "b"},
+			"C": []byte(`{"c":true}`),
+		}))
+
+		// --- When ---
+		have, err := ToTagSet(
+			meta,
+			reg,
+			WithDefinitions(defs...),
+			WithBytesFormat(BytesBase64),
+		)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := map[string]any{
+			"A": []byte(`{"a":1}`),
+			"B": []byte(`[1,"b"]`),
+			"C": []byte(`{"c":true}`),
+		}
+		assert.Equal(t, want, have.MetaGetAll())
+		assert.Equal(t, KindJSON, have.TagGet("A").TagKind())
+	})
+
+	t.Run("kinds from schema", func(t *testing.T) {
+		// --- Given ---
+		reg := tstConvRegistry()
+		sch := NewSchema("test")
+		sch.Require(Define("A", reg.SpecForKind(KindString)))
+		meta := NewMetaSet(WithMeta(map[string]any{"A": "42", "B": "42"}))
+
+		// --- When ---
+		have, err := ToTagSet(meta, reg, WithSchema(sch))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"A": "42", "B": "42"}, have.MetaGetAll())
+	})
+
+	t.Run("registry options", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry(WithTimeFormat(time.DateTime))
+		must.Nil(reg.Register(tstConvSpec[time.Time](KindTime)))
+		def := Define("A", reg.SpecForKind(KindTime))
+		meta := NewMetaSet(WithMeta(map[string]any{
+			"A": "2026-01-02 03:04:05",
+		}))
+
+		// --- When ---
+		have, err := ToTagSet(meta, reg, WithDefinitions(def))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		tim := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		assert.Equal(t, map[string]any{"A": tim}, have.MetaGetAll())
+	})
+
+	t.Run("definition rules are not validated", func(t *testing.T) {
+		// --- Given ---
+		def := Define("A", TstIntSpec(), &TstRule{Err: ErrInvValue})
+		meta := NewMetaSet(WithMeta(map[string]any{"A": 42}))
+
+		// --- When ---
+		have, err := ToTagSet(meta, NewRegistry(), WithDefinitions(def))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"A": 42}, have.MetaGetAll())
+	})
+
+	t.Run("nil registry", func(t *testing.T) {
+		// --- Given ---
+		meta := NewMetaSet(WithMeta(map[string]any{"A": 42}))
+
+		// --- When ---
+		have, err := ToTagSet(meta, nil)
+
+		// --- Then ---
+		fields := tstFieldErrors(t, err)
+		assert.ErrorIs(t, ErrNoCreator, fields["A"])
+		assert.Equal(t, 0, have.TagCount())
+	})
+
+	t.Run("error - collects errors per key", func(t *testing.T) {
+		// --- Given ---
+		reg := tstConvRegistry()
+		defs := []*Definition{
+			Define("A", reg.SpecForKind(KindInt)),
+			Define("B", reg.SpecForKind(KindByteSlice)),
+		}
+		meta := NewMetaSet(WithMeta(map[string]any{
+			"A": "abc",
+			"B": "AQI=",
+			"C": []any{1.0, "a"},
+			"D": 42,
+		}))
+
+		// --- When ---
+		have, err := ToTagSet(meta, reg, WithDefinitions(defs...))
+
+		// --- Then ---
+		fields := tstFieldErrors(t, err)
+		assert.Len(t, 3, fields)
+		assert.ErrorEqual(t, "A: invalid element format", fields["A"])
+		assert.ErrorIs(t, ErrInvType, fields["B"])
+		assert.ErrorIs(t, ErrNoCreator, fields["C"])
+		assert.Equal(t, 0, have.TagCount())
+	})
+}
+
+func Test_ToMetaSet(t *testing.T) {
+	t.Run("values as they are", func(t *testing.T) {
+		// --- Given ---
+		tim := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		set := tstSet(
+			tstInt("A", 42),
+			NewSingle("B", tim, KindTime, nil, nil, nil),
+			NewSlice("C", []byte{1, 2}, KindByteSlice, nil, nil, nil),
+		)
+
+		// --- When ---
+		have := ToMetaSet(set)
+
+		// --- Then ---
+		want := map[string]any{"A": 42, "B": tim, "C": []byte{1, 2}}
+		assert.Equal(t, want, have.MetaGetAll())
+	})
+
+	t.Run("time as string", func(t *testing.T) {
+		// --- Given ---
+		tim := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		set := tstSet(
+			NewSingle("A", tim, KindTime, nil, nil, nil),
+			NewSlice("B", []time.Time{tim}, KindTimeSlice, nil, nil, nil),
+		)
+		loc := must.Value(time.LoadLocation("Europe/Warsaw"))
+
+		// --- When ---
+		have := ToMetaSet(
+			set,
+			WithTimeString,
+			WithTimeFormat(time.DateTime),
+			WithTimeLoc(loc),
+		)
+
+		// --- Then ---
+		want := map[string]any{
+			"A": "2026-01-02 04:04:05",
+			"B": []string{"2026-01-02 04:04:05"},
+		}
+		assert.Equal(t, want, have.MetaGetAll())
+	})
+
+	t.Run("bytes as base64", func(t *testing.T) {
+		// --- Given ---
+		set := tstSet(NewSlice("A", []byte{1, 2}, KindByteSlice, nil, nil, nil))
+
+		// --- When ---
+		have := ToMetaSet(set, WithBytesFormat(BytesBase64))
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": "AQI="}, have.MetaGetAll())
+	})
+
+	t.Run("bytes as hex", func(t *testing.T) {
+		// --- Given ---
+		set := tstSet(NewSlice("A", []byte{1, 2}, KindByteSlice, nil, nil, nil))
+
+		// --- When ---
+		have := ToMetaSet(set, WithBytesFormat(BytesHex))
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": "0102"}, have.MetaGetAll())
+	})
+
+	t.Run("json is not a byte slice", func(t *testing.T) {
+		// --- Given ---
+		doc := []byte(`{"a":1}`)
+		set := tstSet(NewSlice("A", doc, KindJSON, nil, nil, nil))
+
+		// --- When ---
+		have := ToMetaSet(set, WithBytesFormat(BytesBase64))
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": doc}, have.MetaGetAll())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		reg := tstConvRegistry()
+		tim := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		doc := []byte(`{"a":1}`)
+		set := tstSet(
+			tstInt("A", 42),
+			NewSingle("B", tim, KindTime, nil, nil, nil),
+			NewSlice("C", []byte{1, 2}, KindByteSlice, nil, nil, nil),
+			NewSlice("D", doc, KindJSON, nil, nil, nil),
+		)
+		opts := []Option{
+			WithTimeString,
+			WithBytesFormat(BytesHex),
+			WithDefinitions(
+				Define("B", reg.SpecForKind(KindTime)),
+				Define("C", reg.SpecForKind(KindByteSlice)),
+				Define("D", reg.SpecForKind(KindJSON)),
+			),
+		}
+
+		// --- When ---
+		have, err := ToTagSet(ToMetaSet(set, opts...), reg, opts...)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, set.MetaGetAll(), have.MetaGetAll())
+		assert.Equal(t, KindJSON, have.TagGet("D").TagKind())
+	})
+}
+
+func Test_castBytes_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val    any
+		format BytesFormat
+		exp    []byte
+	}{
+		{"bytes", []byte{1, 2}, BytesRaw, []byte{1, 2}},
+		{"base64", "AQI=", BytesBase64, []byte{1, 2}},
+		{"hex", "0102", BytesHex, []byte{1, 2}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			opts := NewOptions(WithBytesFormat(tc.format))
+
+			// --- When ---
+			have, err := castBytes(tc.val, opts)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_castBytes(t *testing.T) {
+	t.Run("error - string with raw format", func(t *testing.T) {
+		// --- When ---
+		have, err := castBytes("AQI=", NewOptions())
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid string", func(t *testing.T) {
+		// --- Given ---
+		opts := NewOptions(WithBytesFormat(BytesHex))
+
+		// --- When ---
+		have, err := castBytes("xyz", opts)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := castBytes(42, NewOptions())
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_widen_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val any
+		exp any
+	}{
+		{"integral float", 42.0, 42},
+		{"float", 4.2, 4.2},
+		{"integer json number", json.Number("42"), 42},
+		{"float json number", json.Number("4.2"), 4.2},
+		{"invalid json number", json.Number("abc"), json.Number("abc")},
+		{"strings", []any{"a"}, []string{"a"}},
+		{"bools", []any{true}, []bool{true}},
+		{"ints", []any{1.0, json.Number("2")}, []int{1, 2}},
+		{"floats", []any{1.0, 2.5}, []float64{1, 2.5}},
+		{"mixed", []any{1.0, "a"}, []any{1.0, "a"}},
+		{"empty", []any{}, []any{}},
+		{"other", "a", "a"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := widen(tc.val)
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
@@ -4,9 +4,31 @@
 package nomix
 
 import (
+	"github.com/ctx42/verax/pkg/spec"
 	"github.com/ctx42/verax/pkg/verax"
+	"github.com/ctx42/xrr/pkg/xrr"
 )
 
+// DefinitionSpecName identifies [Definition] in a [spec.Spec].
+const DefinitionSpecName = "definition"
+
+// Names of [spec.Spec] arguments.
+const (
+	argName  = "name"  // Tag name.
+	argKind  = "kind"  // Tag kind spec.
+	argRules = "rules" // Validation rule specs.
+)
+
+// RuleDecoder represents a function creating a validation rule from its
+// [spec.Spec].
+type RuleDecoder func(spc *spec.Spec) (verax.Rule, error)
+
+// specer is an interface implemented by types with [spec.Spec]
+// representation.
+type specer interface {
+	Spec() (*spec.Spec, error)
+}
+
 // Definition represents a named tag definition. In other words, it wraps a
 // [KindSpec] and a tag name.
 type Definition struct {
@@ -84,3 +106,105 @@ func (def *Definition) validate(val any) error {
 	}
 	return nil
 }
+
+// Spec returns the [spec.Spec] representing the definition. It encodes the
+// tag name, the [KindSpec] spec and specs of the validation rules. Returns an
+// error wrapping [ErrNotImpl] if any of the rules doesn't have the Spec
+// method returning its [spec.Spec].
+func (def *Definition) Spec() (*spec.Spec, error) {
+	kSpc, err := def.spec.Spec()
+	if err != nil {
+		return nil, err
+	}
+	spc := spec.NewSpec(DefinitionSpecName).
+		SetArg(argName, def.name).
+		SetArg(argKind, kSpc)
+	if def.rule == nil {
+		return spc, nil
+	}
+
+	rules := []verax.Rule{def.rule}
+	if set, ok := def.rule.(verax.Set); ok {
+		rules = set
+	}
+	rSpcs := make([]*spec.Spec, 0, len(rules))
+	for _, rule := range rules {
+		rs, ok := rule.(specer)
+		if !ok {
+			return nil, NewInternalErrorf(
+				"%s: %s: rule spec %w for %T",
+				DefinitionSpecName,
+				def.name,
+				ErrNotImpl,
+				rule,
+			)
+		}
+		rSpc, err := rs.Spec()
+		if err != nil {
+			return nil, err
+		}
+		rSpcs = append(rSpcs, rSpc)
+	}
+	return spc.SetArg(argRules, rSpcs), nil
+}
+
+// DefinitionFromSpec creates a [Definition] from a [spec.Spec] created by the
+// [Definition.Spec] method. The [Kind] encoded in the spec must be registered
+// in the [Registry]. The decoder is used to create the validation rules, it
+// may be nil if the definition has no rules.
+func DefinitionFromSpec(
+	reg *Registry,
+	spc *spec.Spec,
+	dec RuleDecoder,
+) (*Definition, error) {
+
+	if spc.Name != DefinitionSpecName {
+		return nil, NewInternalErrorf(
+			"%s: invalid spec name: %q",
+			DefinitionSpecName,
+			spc.Name,
+			xrr.WithCode(spec.ECInvSpec),
+		)
+	}
+	name, err := getSpecArg[string](spc.Args, argName, DefinitionSpecName)
+	if err != nil {
+		return nil, err
+	}
+	kSpc, err := getSpecArg[*spec.Spec](spc.Args, argKind, DefinitionSpecName)
+	if err != nil {
+		return nil, err
+	}
+	ks, err := KindSpecFromSpec(reg, kSpc)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := spc.Args[argRules]; !ok {
+		return Define(name, ks), nil
+	}
+
+	rSpcs, err := getSpecArg[[]*spec.Spec](
+		spc.Args,
+		argRules,
+		DefinitionSpecName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if dec == nil && len(rSpcs) > 0 {
+		return nil, NewInternalErrorf(
+			"%s: %s: rule decoder required",
+			DefinitionSpecName,
+			name,
+			xrr.WithCode(spec.ECInvSpec),
+		)
+	}
+	rules := make([]verax.Rule, 0, len(rSpcs))
+	for _, rSpc := range rSpcs {
+		rule, err := dec(rSpc)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return Define(name, ks, rules...), nil
+}
@@ -0,0 +1,407 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/ctx42/verax/pkg/spec"
+	"github.com/ctx42/verax/pkg/verax"
+)
+
+// JSONSchemaDialect is the JSON Schema dialect of documents created by the
+// [MarshalJSONSchema] function.
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// Names of validation rule specs translated to JSON Schema keywords.
+const (
+	ruleMin    = "min"    // Minimum value.
+	ruleMax    = "max"    // Maximum value.
+	ruleLength = "length" // Minimum and maximum length.
+	ruleIn     = "in"     // Allowed values.
+)
+
+// Names of the length rule spec arguments.
+const (
+	argMin = "min" // Minimum length.
+	argMax = "max" // Maximum length, zero for no limit.
+)
+
+// JSON Schema types and formats.
+const (
+	jsTypeString  = "string"
+	jsTypeInteger = "integer"
+	jsTypeNumber  = "number"
+	jsTypeBoolean = "boolean"
+	jsTypeArray   = "array"
+	jsTypeObject  = "object"
+
+	jsFormatDateTime = "date-time"
+	jsFormatUUID     = "uuid"
+	jsFormatInt64    = "int64"
+	jsEncodingBase64 = "base64"
+)
+
+// jsonSchema is the subset of JSON Schema used to describe tag definitions.
+type jsonSchema struct {
+	Schema     string                 `json:"$schema,omitempty"`
+	Type       string                 `json:"type,omitempty"`
+	Format     string                 `json:"format,omitempty"`
+	Encoding   string                 `json:"contentEncoding,omitempty"`
+	Properties map[string]*jsonSchema `json:"properties,omitempty"`
+	Items      *jsonSchema            `json:"items,omitempty"`
+	Minimum    json.Number            `json:"minimum,omitempty"`
+	Maximum    json.Number            `json:"maximum,omitempty"`
+	MinLength  *int                   `json:"minLength,omitempty"`
+	MaxLength  *int                   `json:"maxLength,omitempty"`
+	MinItems   *int                   `json:"minItems,omitempty"`
+	MaxItems   *int                   `json:"maxItems,omitempty"`
+	Enum       []any                  `json:"enum,omitempty"`
+}
+
+// MarshalJSONSchema encodes the definitions as a JSON Schema document
+// describing an object with a property for each definition. Kinds are
+// mapped to JSON types and formats:
+//
+//   - [KindString] - "string",
+//   - [KindInt] - "integer",
+//   - [KindInt64] - "integer" with the "int64" format,
+//   - [KindFloat64] - "number",
+//   - [KindBool] - "boolean",
+//   - [KindTime] - "string" with the "date-time" format,
+//   - [KindUUID] - "string" with the "uuid" format,
+//   - [KindJSON] - any type,
+//   - [KindByteSlice] - "string" with the "base64" content encoding,
+//   - other slice kinds - "array" with items of the base kind.
+//
+// Definition rules are translated to JSON Schema keywords using their
+// [spec.Spec]. Minimum and maximum value rules are translated for numeric
+// kinds, the length rule for string and slice kinds, and the allowed values
+// rule for single value kinds. Returns an error wrapping [ErrNotImpl] if the
+// kind or any of the rules cannot be translated.
+func MarshalJSONSchema(defs ...*Definition) ([]byte, error) {
+	doc := &jsonSchema{
+		Schema:     JSONSchemaDialect,
+		Type:       jsTypeObject,
+		Properties: make(map[string]*jsonSchema, len(defs)),
+	}
+	for _, def := range defs {
+		prop, err := kindJSONSchema(def.TagKind())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", def.TagName(), err)
+		}
+		if err = ruleJSONSchema(prop, def.TagKind(), def.rule); err != nil {
+			return nil, fmt.Errorf("%s: %w", def.TagName(), err)
+		}
+		doc.Properties[def.TagName()] = prop
+	}
+	return json.Marshal(doc)
+}
+
+// UnmarshalJSONSchema decodes the JSON Schema document describing an object
+// to tag definitions sorted by name. Each property is mapped to the [Kind] as
+// described in [MarshalJSONSchema]; untyped properties are mapped to
+// [KindJSON], and the "string" and "integer" types with other formats are
+// mapped to [KindString] and [KindInt]. The [KindSpec] for the kind must be
+// registered in the [Registry].
+//
+// The "minimum", "maximum", "minLength", "maxLength", "minItems",
+// "maxItems" and "enum" keywords are translated to validation rule specs and
+// created with the decoder, which may be nil when none of the keywords are
+// used. Values are parsed with the [KindSpec.TagParse]. Other keywords are
+// ignored.
+//
+// Returns an error wrapping [ErrInvFormat] if the document is not valid, or
+// [ErrNotImpl] when the property cannot be unambiguously mapped, including
+// the "maxLength" and "maxItems" keywords of 0.
+func UnmarshalJSONSchema(
+	reg *Registry,
+	data []byte,
+	dec RuleDecoder,
+) ([]*Definition, error) {
+
+	doc := &jsonSchema{}
+	jd := json.NewDecoder(bytes.NewReader(data))
+	jd.UseNumber()
+	if err := jd.Decode(doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvFormat, err)
+	}
+	if doc.Type != jsTypeObject {
+		const format = "%w: expected %q type, got %q"
+		return nil, fmt.Errorf(format, ErrInvFormat, jsTypeObject, doc.Type)
+	}
+
+	names := slices.Sorted(maps.Keys(doc.Properties))
+	defs := make([]*Definition, 0, len(names))
+	for _, name := range names {
+		def, err := jsonSchemaDefinition(reg, name, doc.Properties[name], dec)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
+// kindJSONSchema returns the JSON Schema for the [Kind].
+func kindJSONSchema(knd Kind) (*jsonSchema, error) {
+	switch knd {
+	case KindString:
+		return &jsonSchema{Type: jsTypeString}, nil
+	case KindInt:
+		return &jsonSchema{Type: jsTypeInteger}, nil
+	case KindInt64:
+		return &jsonSchema{Type: jsTypeInteger, Format: jsFormatInt64}, nil
+	case KindFloat64:
+		return &jsonSchema{Type: jsTypeNumber}, nil
+	case KindBool:
+		return &jsonSchema{Type: jsTypeBoolean}, nil
+	case KindTime:
+		return &jsonSchema{Type: jsTypeString, Format: jsFormatDateTime}, nil
+	case KindUUID:
+		return &jsonSchema{Type: jsTypeString, Format: jsFormatUUID}, nil
+	case KindJSON:
+		return &jsonSchema{}, nil
+	case KindByteSlice:
+		return &jsonSchema{Type: jsTypeString, Encoding: jsEncodingBase64}, nil
+	}
+	if knd.IsSlice() && knd.Base() != KindJSON {
+		items, err := kindJSONSchema(knd &^ KindSlice)
+		if err != nil {
+			return nil, err
+		}
+		return &jsonSchema{Type: jsTypeArray, Items: items}, nil
+	}
+	return nil, fmt.Errorf("%w: JSON Schema for %s", ErrNotImpl, knd)
+}
+
+// ruleJSONSchema adds to the JSON Schema keywords translated from the rule
+// for the [Kind].
+func ruleJSONSchema(sch *jsonSchema, knd Kind, rule verax.Rule) error {
+	if rule == nil {
+		return nil
+	}
+	rules := []verax.Rule{rule}
+	if set, ok := rule.(verax.Set); ok {
+		rules = set
+	}
+	for _, rule = range rules {
+		rs, ok := rule.(specer)
+		if !ok {
+			return fmt.Errorf("%w: JSON Schema for %T", ErrNotImpl, rule)
+		}
+		spc, err := rs.Spec()
+		if err != nil {
+			return err
+		}
+		if !addJSONSchemaKeyword(sch, knd, spc) {
+			const format = "%w: JSON Schema for %q rule and %s"
+			return fmt.Errorf(format, ErrNotImpl, spc.Name, knd)
+		}
+	}
+	return nil
+}
+
+// addJSONSchemaKeyword adds to the JSON Schema the keywords translated from
+// the rule spec. Returns false if the rule cannot be translated for the
+// [Kind].
+func addJSONSchemaKeyword(sch *jsonSchema, knd Kind, spc *spec.Spec) bool {
+	switch spc.Name {
+	case ruleMin, ruleMax:
+		if sch.Type != jsTypeInteger && sch.Type != jsTypeNumber {
+			return false
+		}
+		val, ok := spc.Args[spec.ArgValue]
+		if !ok {
+			return false
+		}
+		num := json.Number(fmt.Sprint(val))
+		if _, err := strconv.ParseFloat(num.String(), 64); err != nil {
+			return false
+		}
+		if spc.Name == ruleMin {
+			sch.Minimum = num
+		} else {
+			sch.Maximum = num
+		}
+		return true
+
+	case ruleLength:
+		lMin, err := getSpecArg[int](spc.Args, argMin, ruleLength)
+		if err != nil {
+			return false
+		}
+		lMax, err := getSpecArg[int](spc.Args, argMax, ruleLength)
+		if err != nil {
+			return false
+		}
+		var pMax *int
+		if lMax > 0 {
+			pMax = &lMax
+		}
+		switch {
+		case sch.Type == jsTypeString && sch.Encoding == "":
+			sch.MinLength, sch.MaxLength = &lMin, pMax
+		case sch.Type == jsTypeArray:
+			sch.MinItems, sch.MaxItems = &lMin, pMax
+		default:
+			return false
+		}
+		return true
+
+	case ruleIn:
+		vals, err := getSpecArg[[]any](spc.Args, spec.ArgValue, ruleIn)
+		if err != nil || knd.IsSlice() {
+			return false
+		}
+		sch.Enum = vals
+		return true
+	}
+	return false
+}
+
+// jsonSchemaKind returns the [Kind] for the JSON Schema.
+func jsonSchemaKind(sch *jsonSchema) (Kind, error) {
+	switch sch.Type {
+	case "":
+		return KindJSON, nil
+	case jsTypeString:
+		switch {
+		case sch.Encoding == jsEncodingBase64:
+			return KindByteSlice, nil
+		case sch.Format == jsFormatDateTime:
+			return KindTime, nil
+		case sch.Format == jsFormatUUID:
+			return KindUUID, nil
+		}
+		return KindString, nil
+	case jsTypeInteger:
+		if sch.Format == jsFormatInt64 {
+			return KindInt64, nil
+		}
+		return KindInt, nil
+	case jsTypeNumber:
+		return KindFloat64, nil
+	case jsTypeBoolean:
+		return KindBool, nil
+	case jsTypeArray:
+		if sch.Items == nil || sch.Items.Type == "" {
+			break
+		}
+		knd, err := jsonSchemaKind(sch.Items)
+		if err != nil || knd.IsSlice() {
+			break
+		}
+		return knd | KindSlice, nil
+	}
+	return 0, fmt.Errorf("%w: kind for %q type", ErrNotImpl, sch.Type)
+}
+
+// jsonSchemaDefinition creates the named [Definition] from the JSON Schema.
+func jsonSchemaDefinition(
+	reg *Registry,
+	name string,
+	sch *jsonSchema,
+	dec RuleDecoder,
+) (*Definition, error) {
+
+	knd, err := jsonSchemaKind(sch)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	ks := reg.SpecForKind(knd)
+	if ks.IsZero() {
+		const format = "%s: %w for %[3]s(%[3]d)"
+		return nil, fmt.Errorf(format, name, ErrNoSpec, knd)
+	}
+	rSpcs, err := jsonSchemaRuleSpecs(name, sch, ks)
+	if err != nil {
+		return nil, err
+	}
+	if len(rSpcs) == 0 {
+		return Define(name, ks), nil
+	}
+	if dec == nil {
+		return nil, fmt.Errorf("%s: %w: rule decoder", name, ErrMissing)
+	}
+	rules := make([]verax.Rule, 0, len(rSpcs))
+	for _, rSpc := range rSpcs {
+		rule, err := dec(rSpc)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		rules = append(rules, rule)
+	}
+	return Define(name, ks, rules...), nil
+}
+
+// jsonSchemaRuleSpecs returns validation rule specs translated from the JSON
+// Schema keywords. The values are parsed with the [KindSpec].
+func jsonSchemaRuleSpecs(
+	name string,
+	sch *jsonSchema,
+	ks KindSpec,
+) ([]*spec.Spec, error) {
+
+	var spcs []*spec.Spec
+	for _, kw := range []struct {
+		rule string
+		num  json.Number
+	}{{ruleMin, sch.Minimum}, {ruleMax, sch.Maximum}} {
+		if kw.num == "" {
+			continue
+		}
+		tag, err := ks.TagParse(name, kw.num.String())
+		if err != nil {
+			return nil, err
+		}
+		spcs = append(spcs, spec.NewSpec(kw.rule).
+			SetArg(spec.ArgValue, tag.TagValue()))
+	}
+
+	lMin, lMax, kwMax := sch.MinLength, sch.MaxLength, "maxLength"
+	if sch.Type == jsTypeArray {
+		lMin, lMax, kwMax = sch.MinItems, sch.MaxItems, "maxItems"
+	}
+	if lMax != nil && *lMax == 0 {
+		// The length rule treats the zero maximum as no maximum.
+		return nil, fmt.Errorf("%s: %w: %s of 0", name, ErrNotImpl, kwMax)
+	}
+	if lMin != nil || lMax != nil {
+		spc := spec.NewSpec(ruleLength).SetArg(argMin, 0).SetArg(argMax, 0)
+		if lMin != nil {
+			spc.SetArg(argMin, *lMin)
+		}
+		if lMax != nil {
+			spc.SetArg(argMax, *lMax)
+		}
+		spcs = append(spcs, spc)
+	}
+
+	if len(sch.Enum) > 0 {
+		vals := make([]any, 0, len(sch.Enum))
+		for _, val := range sch.Enum {
+			str, ok := val.(string)
+			if !ok || ks.TagKind() == KindJSON {
+				data, err := json.Marshal(val)
+				if err != nil {
+					return nil, err
+				}
+				str = string(data)
+			}
+			tag, err := ks.TagParse(name, str)
+			if err != nil {
+				return nil, err
+			}
+			vals = append(vals, tag.TagValue())
+		}
+		spcs = append(spcs, spec.NewSpec(ruleIn).SetArg(spec.ArgValue, vals))
+	}
+	return spcs, nil
+}
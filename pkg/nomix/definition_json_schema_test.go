@@ -0,0 +1,509 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/verax/pkg/spec"
+	"github.com/ctx42/verax/pkg/verax"
+)
+
+func Test_MarshalJSONSchema(t *testing.T) {
+	t.Run("definitions", func(t *testing.T) {
+		// --- Given ---
+		defs := []*Definition{
+			Define("id", TstIntSpec(), verax.Min(1), verax.Max(10)),
+			Define("env", tstStrSpec(), verax.In("prod", "qa")),
+			Define("name", tstStrSpec(), verax.Length(1, 0)),
+			Define("ids", NewKindSpec(KindIntSlice, nil, nil)),
+		}
+
+		// --- When ---
+		have, err := MarshalJSONSchema(defs...)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `{
+			"$schema": "https://json-schema.org/draft/2020-12/schema",
+			"type": "object",
+			"properties": {
+				"env": {"type": "string", "enum": ["prod", "qa"]},
+				"id": {"type": "integer", "minimum": 1, "maximum": 10},
+				"ids": {"type": "array", "items": {"type": "integer"}},
+				"name": {"type": "string", "minLength": 1}
+			}
+		}`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("no definitions", func(t *testing.T) {
+		// --- When ---
+		have, err := MarshalJSONSchema()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `{
+			"$schema": "https://json-schema.org/draft/2020-12/schema",
+			"type": "object"
+		}`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("slice length", func(t *testing.T) {
+		// --- Given ---
+		ks := NewKindSpec(KindStringSlice, nil, nil)
+		def := Define("names", ks, verax.Length(1, 3))
+
+		// --- When ---
+		have, err := MarshalJSONSchema(def)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `{
+			"$schema": "https://json-schema.org/draft/2020-12/schema",
+			"type": "object",
+			"properties": {
+				"names": {
+					"type": "array",
+					"items": {"type": "string"},
+					"minItems": 1,
+					"maxItems": 3
+				}
+			}
+		}`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("error - kind", func(t *testing.T) {
+		// --- Given ---
+		def := Define("A", NewKindSpec(KindJSON|KindSlice, nil, nil))
+
+		// --- When ---
+		have, err := MarshalJSONSchema(def)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotImpl, err)
+		assert.ErrorEqual(t, "A: not implemented: JSON Schema for "+
+			"KindUnknown", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - rule without spec", func(t *testing.T) {
+		// --- Given ---
+		def := Define("A", TstIntSpec(), &TstRule{})
+
+		// --- When ---
+		have, err := MarshalJSONSchema(def)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotImpl, err)
+		assert.ErrorEqual(t, "A: not implemented: JSON Schema for "+
+			"*nomix.TstRule", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - rule not matching kind", func(t *testing.T) {
+		// --- Given ---
+		def := Define("A", tstStrSpec(), verax.Min(1))
+
+		// --- When ---
+		have, err := MarshalJSONSchema(def)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotImpl, err)
+		assert.ErrorEqual(t, `A: not implemented: JSON Schema for "min" `+
+			"rule and KindString", err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_kindJSONSchema_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		knd Kind
+		exp string
+	}{
+		{"string", KindString, `{"type": "string"}`},
+		{"int", KindInt, `{"type": "integer"}`},
+		{"int64", KindInt64, `{"type": "integer", "format": "int64"}`},
+		{"float64", KindFloat64, `{"type": "number"}`},
+		{"bool", KindBool, `{"type": "boolean"}`},
+		{"time", KindTime, `{"type": "string", "format": "date-time"}`},
+		{"uuid", KindUUID, `{"type": "string", "format": "uuid"}`},
+		{"json", KindJSON, `{}`},
+		{
+			"byte slice",
+			KindByteSlice,
+			`{"type": "string", "contentEncoding": "base64"}`,
+		},
+		{
+			"time slice",
+			KindTimeSlice,
+			`{
+				"type": "array",
+				"items": {"type": "string", "format": "date-time"}
+			}`,
+		},
+		{
+			"bool slice",
+			KindBoolSlice,
+			`{"type": "array", "items": {"type": "boolean"}}`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := kindJSONSchema(tc.knd)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.JSON(t, tc.exp, string(must.Value(json.Marshal(have))))
+		})
+	}
+}
+
+func Test_jsonSchemaKind_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		sch *jsonSchema
+		exp Kind
+	}{
+		{"untyped", &jsonSchema{}, KindJSON},
+		{"string", &jsonSchema{Type: "string"}, KindString},
+		{
+			"string with other format",
+			&jsonSchema{Type: "string", Format: "email"},
+			KindString,
+		},
+		{
+			"date-time",
+			&jsonSchema{Type: "string", Format: "date-time"},
+			KindTime,
+		},
+		{"uuid", &jsonSchema{Type: "string", Format: "uuid"}, KindUUID},
+		{
+			"base64",
+			&jsonSchema{Type: "string", Encoding: "base64"},
+			KindByteSlice,
+		},
+		{"integer", &jsonSchema{Type: "integer"}, KindInt},
+		{
+			"integer with other format",
+			&jsonSchema{Type: "integer", Format: "int32"},
+			KindInt,
+		},
+		{"int64", &jsonSchema{Type: "integer", Format: "int64"}, KindInt64},
+		{"number", &jsonSchema{Type: "number"}, KindFloat64},
+		{"boolean", &jsonSchema{Type: "boolean"}, KindBool},
+		{
+			"array",
+			&jsonSchema{Type: "array", Items: &jsonSchema{Type: "number"}},
+			KindFloat64Slice,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := jsonSchemaKind(tc.sch)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_jsonSchemaKind_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		sch *jsonSchema
+	}{
+		{"object", &jsonSchema{Type: "object"}},
+		{"null", &jsonSchema{Type: "null"}},
+		{"array without items", &jsonSchema{Type: "array"}},
+		{
+			"array of untyped",
+			&jsonSchema{Type: "array", Items: &jsonSchema{}},
+		},
+		{
+			"array of arrays",
+			&jsonSchema{
+				Type:  "array",
+				Items: &jsonSchema{Type: "array", Items: &jsonSchema{}},
+			},
+		},
+		{
+			"array of base64",
+			&jsonSchema{
+				Type:  "array",
+				Items: &jsonSchema{Type: "string", Encoding: "base64"},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := jsonSchemaKind(tc.sch)
+
+			// --- Then ---
+			assert.ErrorIs(t, ErrNotImpl, err)
+			assert.Equal(t, Kind(0), have)
+		})
+	}
+}
+
+func Test_UnmarshalJSONSchema(t *testing.T) {
+	t.Run("definitions", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{
+			"type": "object",
+			"properties": {
+				"id": {"type": "integer", "minimum": 1, "maximum": 10},
+				"env": {"type": "string", "enum": ["prod", "qa"]},
+				"name": {"type": "string", "maxLength": 3, "title": "Name"}
+			}
+		}`)
+
+		// --- When ---
+		have, err := UnmarshalJSONSchema(
+			TstRegistry(),
+			data,
+			tstRuleDecoder,
+		)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 3, have)
+
+		assert.Equal(t, "env", have[0].TagName())
+		assert.Equal(t, KindString, have[0].TagKind())
+		assert.Equal(t, verax.In("prod", "qa"), have[0].TagRule())
+
+		assert.Equal(t, "id", have[1].TagName())
+		assert.Equal(t, KindInt, have[1].TagKind())
+		exp := verax.Set{verax.Min(1), verax.Max(10)}
+		assert.Equal(t, exp, have[1].TagRule())
+
+		assert.Equal(t, "name", have[2].TagName())
+		assert.Equal(t, verax.Length(0, 3), have[2].TagRule())
+		assert.Error(t, have[2].Validate("abcd"))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		defs := []*Definition{
+			Define("env", tstStrSpec(), verax.In("prod", "qa")),
+			Define("id", TstIntSpec(), verax.Min(1), verax.Max(10)),
+		}
+		data := must.Value(MarshalJSONSchema(defs...))
+
+		// --- When ---
+		have, err := UnmarshalJSONSchema(
+			TstRegistry(),
+			data,
+			tstRuleDecoder,
+		)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 2, have)
+		assert.Equal(t, defs[0].TagRule(), have[0].TagRule())
+		assert.Equal(t, defs[1].TagRule(), have[1].TagRule())
+	})
+
+	t.Run("without rules", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"type": "object", "properties": {"id": {
+			"type": "integer"}}}`)
+
+		// --- When ---
+		have, err := UnmarshalJSONSchema(TstRegistry(), data, nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 1, have)
+		assert.Nil(t, have[0].TagRule())
+	})
+
+	t.Run("no properties", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"type": "object"}`)
+
+		// --- When ---
+		have, err := UnmarshalJSONSchema(NewRegistry(), data, nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 0, have)
+	})
+
+	t.Run("error - invalid JSON", func(t *testing.T) {
+		// --- When ---
+		have, err := UnmarshalJSONSchema(NewRegistry(), []byte("{"), nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - not an object", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"type": "string"}`)
+
+		// --- When ---
+		have, err := UnmarshalJSONSchema(NewRegistry(), data, nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		wMsg := `invalid element format: expected "object" type, got "string"`
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - multiple types", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"type": "object", "properties": {"A": {
+			"type": ["string", "null"]}}}`)
+
+		// --- When ---
+		have, err := UnmarshalJSONSchema(NewRegistry(), data, nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - not mapped kind", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"type": "object", "properties": {"A": {
+			"type": "null"}}}`)
+
+		// --- When ---
+		have, err := UnmarshalJSONSchema(NewRegistry(), data, nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotImpl, err)
+		assert.ErrorEqual(t, `A: not implemented: kind for "null" type`, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - zero maxLength", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"type": "object", "properties": {"A": {
+			"type": "string", "maxLength": 0}}}`)
+
+		// --- When ---
+		have, err := UnmarshalJSONSchema(TstRegistry(), data, tstRuleDecoder)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotImpl, err)
+		assert.ErrorEqual(t, "A: not implemented: maxLength of 0", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - zero maxItems", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"type": "object", "properties": {"A": {
+			"type": "array", "items": {"type": "integer"}, "maxItems": 0}}}`)
+		reg := TstRegistry()
+		must.Nil(reg.Register(NewKindSpec(KindIntSlice, nil, nil)))
+
+		// --- When ---
+		have, err := UnmarshalJSONSchema(reg, data, tstRuleDecoder)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotImpl, err)
+		assert.ErrorEqual(t, "A: not implemented: maxItems of 0", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - kind not registered", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"type": "object", "properties": {"A": {
+			"type": "boolean"}}}`)
+
+		// --- When ---
+		have, err := UnmarshalJSONSchema(TstRegistry(), data, nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNoSpec, err)
+		assert.ErrorEqual(t, "A: spec not found for KindBool(260)", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid keyword value", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"type": "object", "properties": {"A": {
+			"type": "integer", "minimum": 1.5}}}`)
+
+		// --- When ---
+		have, err := UnmarshalJSONSchema(
+			TstRegistry(),
+			data,
+			tstRuleDecoder,
+		)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid enum value", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"type": "object", "properties": {"A": {
+			"type": "integer", "enum": [1, "a"]}}}`)
+
+		// --- When ---
+		have, err := UnmarshalJSONSchema(
+			TstRegistry(),
+			data,
+			tstRuleDecoder,
+		)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - decoder required", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"type": "object", "properties": {"A": {
+			"type": "integer", "minimum": 1}}}`)
+
+		// --- When ---
+		have, err := UnmarshalJSONSchema(TstRegistry(), data, nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.ErrorEqual(t, "A: missing element: rule decoder", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - decoder", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"type": "object", "properties": {"A": {
+			"type": "integer", "minimum": 1}}}`)
+		dec := func(*spec.Spec) (verax.Rule, error) {
+			return nil, errors.New("test error")
+		}
+
+		// --- When ---
+		have, err := UnmarshalJSONSchema(TstRegistry(), data, dec)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "A: test error", err)
+		assert.Nil(t, have)
+	})
+}
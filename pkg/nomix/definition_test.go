@@ -4,12 +4,32 @@
 package nomix
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/verax/pkg/spec"
 	"github.com/ctx42/verax/pkg/verax"
 )
 
+// tstRuleDecoder is the [RuleDecoder] used in tests. It decodes the
+// [verax.Max] and [verax.Min] rules.
+func tstRuleDecoder(spc *spec.Spec) (verax.Rule, error) {
+	switch spc.Name {
+	case "max":
+		return verax.Max(spc.Args[spec.ArgValue]), nil
+	case "min":
+		return verax.Min(spc.Args[spec.ArgValue]), nil
+	case "length":
+		return verax.Length(spc.Args["min"].(int), spc.Args["max"].(int)), nil
+	case "in":
+		return verax.In(spc.Args[spec.ArgValue].([]any)...), nil
+	default:
+		return nil, errors.New("unknown rule")
+	}
+}
+
 func Test_Define(t *testing.T) {
 	t.Run("no rules", func(t *testing.T) {
 		// --- Given ---
@@ -250,3 +270,199 @@ func Test_Definition_Validate(t *testing.T) {
 		assert.ErrorEqual(t, "name: must be less or equal to 44", errMax)
 	})
 }
+
+func Test_Definition_Spec(t *testing.T) {
+	t.Run("without rules", func(t *testing.T) {
+		// --- Given ---
+		def := Define("name", TstIntSpec())
+
+		// --- When ---
+		have, err := def.Spec()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, DefinitionSpecName, have.Name)
+		assert.Len(t, 2, have.Args)
+		assert.Equal(t, "name", have.Args[argName])
+		kSpc := spec.NewSpec(KindSpecName).SetArg(spec.ArgValue, int16(KindInt))
+		assert.Equal(t, kSpc, have.Args[argKind])
+	})
+
+	t.Run("with one rule", func(t *testing.T) {
+		// --- Given ---
+		def := Define("name", TstIntSpec(), verax.Max(42))
+
+		// --- When ---
+		have, err := def.Spec()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := []*spec.Spec{spec.NewSpec("max").SetArg(spec.ArgValue, 42)}
+		assert.Equal(t, exp, have.Args[argRules])
+	})
+
+	t.Run("with rules", func(t *testing.T) {
+		// --- Given ---
+		def := Define("name", TstIntSpec(), verax.Min(1), verax.Max(42))
+
+		// --- When ---
+		have, err := def.Spec()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := []*spec.Spec{
+			spec.NewSpec("min").SetArg(spec.ArgValue, 1),
+			spec.NewSpec("max").SetArg(spec.ArgValue, 42),
+		}
+		assert.Equal(t, exp, have.Args[argRules])
+	})
+
+	t.Run("error - rule without spec", func(t *testing.T) {
+		// --- Given ---
+		def := Define("name", TstIntSpec(), verax.Max(42), &TstRule{})
+
+		// --- When ---
+		have, err := def.Spec()
+
+		// --- Then ---
+		assert.SameType(t, &InternalError{}, err)
+		assert.ErrorIs(t, ErrNotImpl, err)
+		wMsg := "definition: name: rule spec not implemented for *nomix.TstRule"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_DefinitionFromSpec(t *testing.T) {
+	t.Run("without rules", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		must.Nil(reg.Register(TstIntSpec()))
+		spc := must.Value(Define("name", TstIntSpec()).Spec())
+
+		// --- When ---
+		have, err := DefinitionFromSpec(reg, spc, nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "name", have.TagName())
+		assert.Equal(t, KindInt, have.TagKind())
+		assert.Nil(t, have.TagRule())
+	})
+
+	t.Run("with rules", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		must.Nil(reg.Register(TstIntSpec()))
+		def := Define("name", TstIntSpec(), verax.Min(1), verax.Max(42))
+		spc := must.Value(def.Spec())
+
+		// --- When ---
+		have, err := DefinitionFromSpec(reg, spc, tstRuleDecoder)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "name", have.TagName())
+		assert.Equal(t, verax.Set{verax.Min(1), verax.Max(42)}, have.TagRule())
+		assert.NoError(t, have.Validate(42))
+		assert.Error(t, have.Validate(44))
+	})
+
+	t.Run("error - invalid spec name", func(t *testing.T) {
+		// --- Given ---
+		spc := spec.NewSpec("other")
+
+		// --- When ---
+		have, err := DefinitionFromSpec(NewRegistry(), spc, nil)
+
+		// --- Then ---
+		assert.SameType(t, &InternalError{}, err)
+		assert.ErrorEqual(t, `definition: invalid spec name: "other"`, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - missing name argument", func(t *testing.T) {
+		// --- Given ---
+		spc := spec.NewSpec(DefinitionSpecName)
+
+		// --- When ---
+		have, err := DefinitionFromSpec(NewRegistry(), spc, nil)
+
+		// --- Then ---
+		wMsg := "definition: spec missing required argument: name"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - missing kind argument", func(t *testing.T) {
+		// --- Given ---
+		spc := spec.NewSpec(DefinitionSpecName).SetArg(argName, "name")
+
+		// --- When ---
+		have, err := DefinitionFromSpec(NewRegistry(), spc, nil)
+
+		// --- Then ---
+		wMsg := "definition: spec missing required argument: kind"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - kind not registered", func(t *testing.T) {
+		// --- Given ---
+		spc := must.Value(Define("name", TstIntSpec()).Spec())
+
+		// --- When ---
+		have, err := DefinitionFromSpec(NewRegistry(), spc, nil)
+
+		// --- Then ---
+		assert.ErrorEqual(t, `kind-spec: invalid spec kind: 516`, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid rules argument", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		must.Nil(reg.Register(TstIntSpec()))
+		spc := must.Value(Define("name", TstIntSpec()).Spec())
+		spc.SetArg(argRules, "abc")
+
+		// --- When ---
+		have, err := DefinitionFromSpec(reg, spc, tstRuleDecoder)
+
+		// --- Then ---
+		wMsg := `definition: spec argument "rules" must be []*spec.Spec, ` +
+			`got string`
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - missing rule decoder", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		must.Nil(reg.Register(TstIntSpec()))
+		spc := must.Value(Define("name", TstIntSpec(), verax.Max(1)).Spec())
+
+		// --- When ---
+		have, err := DefinitionFromSpec(reg, spc, nil)
+
+		// --- Then ---
+		assert.SameType(t, &InternalError{}, err)
+		assert.ErrorEqual(t, "definition: name: rule decoder required", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - rule decoder", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		must.Nil(reg.Register(TstIntSpec()))
+		spc := must.Value(Define("name", TstIntSpec(), verax.Max(1)).Spec())
+		spc.Args[argRules] = []*spec.Spec{spec.NewSpec("other")}
+
+		// --- When ---
+		have, err := DefinitionFromSpec(reg, spc, tstRuleDecoder)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "unknown rule", err)
+		assert.Nil(t, have)
+	})
+}
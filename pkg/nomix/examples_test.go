@@ -24,7 +24,7 @@ func ExampleCreateFunc() {
 			sqlValue := func(val int) (driver.Value, error) {
 				return int64(val), nil
 			}
-			return nomix.NewSingle(name, v, nomix.KindInt, strconv.Itoa, sqlValue), nil
+			return nomix.NewSingle(name, v, nomix.KindInt, strconv.Itoa, sqlValue, nil), nil
 		}
 		return nil, nomix.ErrInvType
 	}
@@ -138,3 +138,41 @@ func ExampleMetaSet() {
 	// - C: foo
 	// - D: <nil>
 }
+
+func ExampleMarshalTagSet() {
+	set := nomix.NewTagSet()
+	set.TagSet(
+		xtag.NewInt("A", 42),
+		xtag.NewInt64("B", 42),
+		xtag.NewStringSlice("C", "foo", "bar"),
+	)
+
+	data, _ := nomix.MarshalTagSet(set)
+	fmt.Println(string(data))
+
+	reg := nomix.NewRegistry()
+	xtag.RegisterAll(reg)
+
+	have, _ := nomix.UnmarshalTagSet(reg, data)
+	fmt.Printf("- A: %s %v\n", have.TagGet("A").TagKind(), have.TagGet("A"))
+	fmt.Printf("- B: %s %v\n", have.TagGet("B").TagKind(), have.TagGet("B"))
+	fmt.Printf("- C: %s %v\n", have.TagGet("C").TagKind(), have.TagGet("C"))
+
+	// Output:
+	// {"A":[516,42],"B":[4,42],"C":[130,["foo","bar"]]}
+	// - A: KindInt 42
+	// - B: KindInt64 42
+	// - C: KindStringSlice ["foo", "bar"]
+}
+
+func ExampleWithSliceEncoder() {
+	reg := nomix.NewRegistry(nomix.WithSliceEncoder(nomix.PGArray{}))
+	xtag.RegisterAll(reg)
+
+	tag, _ := reg.Create("A", []int{1, 2})
+	val, _ := tag.(driver.Valuer).Value()
+	fmt.Println(val)
+
+	// Output:
+	// {1,2}
+}
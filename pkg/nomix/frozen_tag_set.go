@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+// Compile time checks.
+var (
+	_ AllGetter     = &FrozenTagSet{}
+	_ MetaAllGetter = &FrozenTagSet{}
+)
+
+// frozenMaxDepth is the maximum number of [FrozenTagSet] derivations sharing
+// the tags with the set they were derived from. Derivations beyond it are
+// flattened to keep lookups fast.
+const frozenMaxDepth = 8
+
+// FrozenTagSet represents an immutable set of tags. It is safe for
+// concurrent use and can be shared, for example, between caches.
+//
+// The set has no methods modifying it. Instead, the [FrozenTagSet.With] and
+// [FrozenTagSet.Without] methods derive new sets sharing the tags with the
+// original one. Tags are copied when added to the set and returned from it
+// using the [Cloner] interface, tags not implementing it are used as they
+// are.
+type FrozenTagSet struct {
+	parent *FrozenTagSet  // The set this one was derived from.
+	delta  map[string]Tag // Tags changed in relation to the parent.
+	count  int            // Number of tags in the set.
+	depth  int            // Number of parents.
+}
+
+// NewFrozenTagSet returns a new instance of [FrozenTagSet] with copies of the
+// given tags. The nil instances are ignored.
+func NewFrozenTagSet(tags ...Tag) *FrozenTagSet {
+	set := &FrozenTagSet{delta: make(map[string]Tag, len(tags))}
+	for _, tag := range tags {
+		if tag != nil {
+			set.delta[tag.TagName()] = cloneTag(tag)
+		}
+	}
+	set.count = len(set.delta)
+	return set
+}
+
+// Freeze returns a new instance of [FrozenTagSet] with copies of all the tags
+// in the given set.
+func Freeze(src AllGetter) *FrozenTagSet {
+	all := src.TagGetAll()
+	set := &FrozenTagSet{delta: make(map[string]Tag, len(all))}
+	for name, tag := range all {
+		if tag != nil {
+			set.delta[name] = cloneTag(tag)
+		}
+	}
+	set.count = len(set.delta)
+	return set
+}
+
+// TagGet returns a copy of the named tag. If the name doesn't exist in the
+// set, it returns nil.
+func (set *FrozenTagSet) TagGet(name string) Tag {
+	if tag := set.get(name); tag != nil {
+		return cloneTag(tag)
+	}
+	return nil
+}
+
+// TagCount returns the number of entries in the tag set.
+func (set *FrozenTagSet) TagCount() int { return set.count }
+
+// TagGetAll returns a map with copies of all tags in the set.
+func (set *FrozenTagSet) TagGetAll() map[string]Tag {
+	all := set.all()
+	for name, tag := range all {
+		all[name] = cloneTag(tag)
+	}
+	return all
+}
+
+func (set *FrozenTagSet) MetaGetAll() map[string]any {
+	if set.count == 0 {
+		return nil
+	}
+	return TagSet{m: set.TagGetAll()}.MetaGetAll()
+}
+
+// Thaw returns a [TagSet] with copies of all tags in the set.
+func (set *FrozenTagSet) Thaw() TagSet {
+	return TagSet{m: set.TagGetAll()}
+}
+
+// With returns a new set with copies of the given tags added. If the tag
+// name already exists in the set, it will be overwritten in the new set.
+// The nil instances are ignored.
+func (set *FrozenTagSet) With(tags ...Tag) *FrozenTagSet {
+	delta := make(map[string]Tag, len(tags))
+	count := set.count
+	for _, tag := range tags {
+		if tag == nil {
+			continue
+		}
+		name := tag.TagName()
+		if _, ok := delta[name]; !ok && set.get(name) == nil {
+			count++
+		}
+		delta[name] = cloneTag(tag)
+	}
+	if len(delta) == 0 {
+		return set
+	}
+	return set.derive(delta, count)
+}
+
+// Without returns a new set without the named tags. Names not existing in
+// the set are ignored.
+func (set *FrozenTagSet) Without(names ...string) *FrozenTagSet {
+	delta := make(map[string]Tag, len(names))
+	count := set.count
+	for _, name := range names {
+		if _, ok := delta[name]; ok || set.get(name) == nil {
+			continue
+		}
+		delta[name] = nil
+		count--
+	}
+	if len(delta) == 0 {
+		return set
+	}
+	return set.derive(delta, count)
+}
+
+// derive returns a new set derived from this one with the changed tags,
+// where nil tags represent removed ones.
+func (set *FrozenTagSet) derive(delta map[string]Tag, count int) *FrozenTagSet {
+	if set.depth+1 < frozenMaxDepth {
+		return &FrozenTagSet{
+			parent: set,
+			delta:  delta,
+			count:  count,
+			depth:  set.depth + 1,
+		}
+	}
+	all := set.all()
+	for name, tag := range delta {
+		if tag == nil {
+			delete(all, name)
+			continue
+		}
+		all[name] = tag
+	}
+	return &FrozenTagSet{delta: all, count: count}
+}
+
+// get returns the named tag without copying it, or nil if it doesn't exist.
+func (set *FrozenTagSet) get(name string) Tag {
+	for cur := set; cur != nil; cur = cur.parent {
+		if tag, ok := cur.delta[name]; ok {
+			return tag
+		}
+	}
+	return nil
+}
+
+// all returns a new map with all tags in the set without copying them.
+func (set *FrozenTagSet) all() map[string]Tag {
+	all := make(map[string]Tag, set.count)
+	for cur := set; cur != nil; cur = cur.parent {
+		for name, tag := range cur.delta {
+			if _, ok := all[name]; !ok {
+				all[name] = tag
+			}
+		}
+	}
+	for name, tag := range all {
+		if tag == nil {
+			delete(all, name)
+		}
+	}
+	return all
+}
+
+// cloneTag returns a copy of the tag if it implements the [Cloner]
+// interface, otherwise it returns the tag.
+func cloneTag(tag Tag) Tag {
+	if cln, ok := tag.(Cloner); ok {
+		return cln.TagClone()
+	}
+	return tag
+}
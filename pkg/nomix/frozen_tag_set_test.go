@@ -0,0 +1,287 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_NewFrozenTagSet(t *testing.T) {
+	t.Run("tags", func(t *testing.T) {
+		// --- Given ---
+		tag := tstInts("A", 1, 2)
+
+		// --- When ---
+		have := NewFrozenTagSet(tag, nil, tstInt("B", 2))
+
+		// --- Then ---
+		assert.Equal(t, 2, have.TagCount())
+		assert.Nil(t, have.parent)
+		assert.NotSame(t, tag, have.delta["A"])
+		tag.(*Slice[int]).Get()[0] = 10
+		assert.Equal(t, []int{1, 2}, have.TagGet("A").TagValue())
+	})
+
+	t.Run("no tags", func(t *testing.T) {
+		// --- When ---
+		have := NewFrozenTagSet()
+
+		// --- Then ---
+		assert.Equal(t, 0, have.TagCount())
+		assert.Len(t, 0, have.TagGetAll())
+	})
+}
+
+func Test_Freeze(t *testing.T) {
+	t.Run("freeze", func(t *testing.T) {
+		// --- Given ---
+		src := tstSet(tstInts("A", 1, 2), tstInt("B", 2))
+
+		// --- When ---
+		have := Freeze(src)
+
+		// --- Then ---
+		assert.Equal(t, 2, have.TagCount())
+		assert.Nil(t, Diff(src, have))
+		src.TagGet("A").(*Slice[int]).Get()[0] = 10
+		src.TagDelete("B")
+		assert.Equal(t, []int{1, 2}, have.TagGet("A").TagValue())
+		assert.Equal(t, 2, have.TagCount())
+	})
+
+	t.Run("tags not implementing Cloner", func(t *testing.T) {
+		// --- Given ---
+		tag := tstPlainTag{name: "A", value: []int{1}}
+
+		// --- When ---
+		have := Freeze(tstSet(tag))
+
+		// --- Then ---
+		assert.Equal(t, tag, have.TagGet("A"))
+	})
+}
+
+func Test_FrozenTagSet_TagGet(t *testing.T) {
+	t.Run("returns copy", func(t *testing.T) {
+		// --- Given ---
+		set := NewFrozenTagSet(tstInts("A", 1, 2))
+
+		// --- When ---
+		have := set.TagGet("A")
+
+		// --- Then ---
+		have.(*Slice[int]).Get()[0] = 10
+		have.(*Slice[int]).Set([]int{3})
+		assert.Equal(t, []int{1, 2}, set.TagGet("A").TagValue())
+	})
+
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewFrozenTagSet(tstInt("A", 1))
+
+		// --- When ---
+		have := set.TagGet("B")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_FrozenTagSet_TagGetAll(t *testing.T) {
+	// --- Given ---
+	set := NewFrozenTagSet(tstInts("A", 1, 2), tstInt("B", 2))
+
+	// --- When ---
+	have := set.TagGetAll()
+
+	// --- Then ---
+	assert.Len(t, 2, have)
+	have["A"].(*Slice[int]).Get()[0] = 10
+	delete(have, "B")
+	assert.Equal(t, []int{1, 2}, set.TagGet("A").TagValue())
+	assert.Equal(t, 2, set.TagCount())
+}
+
+func Test_FrozenTagSet_MetaGetAll(t *testing.T) {
+	t.Run("values", func(t *testing.T) {
+		// --- Given ---
+		set := NewFrozenTagSet(tstInts("A", 1), tstStr("B", "b"))
+
+		// --- When ---
+		have := set.MetaGetAll()
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": []int{1}, "B": "b"}, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		set := NewFrozenTagSet(tstInt("A", 1)).Without("A")
+
+		// --- When ---
+		have := set.MetaGetAll()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_FrozenTagSet_Thaw(t *testing.T) {
+	// --- Given ---
+	set := NewFrozenTagSet(tstInts("A", 1))
+
+	// --- When ---
+	have := set.Thaw()
+
+	// --- Then ---
+	have.TagSet(tstInt("B", 2))
+	have.TagGet("A").(*Slice[int]).Get()[0] = 10
+	assert.Equal(t, 1, set.TagCount())
+	assert.Equal(t, []int{1}, set.TagGet("A").TagValue())
+}
+
+func Test_FrozenTagSet_With(t *testing.T) {
+	t.Run("add and replace", func(t *testing.T) {
+		// --- Given ---
+		set := NewFrozenTagSet(tstInt("A", 1), tstInt("B", 2))
+
+		// --- When ---
+		have := set.With(tstInt("B", 20), nil, tstInt("C", 3), tstInt("C", 30))
+
+		// --- Then ---
+		assert.Same(t, set, have.parent)
+		assert.Equal(t, 3, have.TagCount())
+		exp := tstSet(tstInt("A", 1), tstInt("B", 20), tstInt("C", 30))
+		assert.Nil(t, Diff(exp, have))
+		assert.Equal(t, 2, set.TagCount())
+		assert.Equal(t, 2, set.TagGet("B").TagValue())
+	})
+
+	t.Run("copies tags", func(t *testing.T) {
+		// --- Given ---
+		tag := tstInts("A", 1)
+
+		// --- When ---
+		have := NewFrozenTagSet().With(tag)
+
+		// --- Then ---
+		tag.(*Slice[int]).Get()[0] = 10
+		assert.Equal(t, []int{1}, have.TagGet("A").TagValue())
+	})
+
+	t.Run("no tags", func(t *testing.T) {
+		// --- Given ---
+		set := NewFrozenTagSet(tstInt("A", 1))
+
+		// --- When ---
+		have := set.With(nil)
+
+		// --- Then ---
+		assert.Same(t, set, have)
+	})
+}
+
+func Test_FrozenTagSet_Without(t *testing.T) {
+	t.Run("remove", func(t *testing.T) {
+		// --- Given ---
+		set := NewFrozenTagSet(tstInt("A", 1), tstInt("B", 2))
+
+		// --- When ---
+		have := set.Without("A", "A", "X")
+
+		// --- Then ---
+		assert.Same(t, set, have.parent)
+		assert.Equal(t, 1, have.TagCount())
+		assert.Nil(t, have.TagGet("A"))
+		assert.Nil(t, Diff(tstSet(tstInt("B", 2)), have))
+		assert.Equal(t, 2, set.TagCount())
+	})
+
+	t.Run("add removed", func(t *testing.T) {
+		// --- Given ---
+		set := NewFrozenTagSet(tstInt("A", 1), tstInt("B", 2)).Without("A")
+
+		// --- When ---
+		have := set.With(tstInt("A", 10))
+
+		// --- Then ---
+		assert.Equal(t, 2, have.TagCount())
+		assert.Equal(t, 10, have.TagGet("A").TagValue())
+	})
+
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewFrozenTagSet(tstInt("A", 1))
+
+		// --- When ---
+		have := set.Without("X")
+
+		// --- Then ---
+		assert.Same(t, set, have)
+	})
+}
+
+func Test_FrozenTagSet_derive(t *testing.T) {
+	t.Run("flattens deep derivations", func(t *testing.T) {
+		// --- Given ---
+		set := NewFrozenTagSet(tstInt("A", 0))
+
+		// --- When ---
+		for i := range 2 * frozenMaxDepth {
+			set = set.With(tstInt(strconv.Itoa(i), i)).Without("A")
+		}
+
+		// --- Then ---
+		assert.True(t, set.depth < frozenMaxDepth)
+		assert.Equal(t, 2*frozenMaxDepth, set.TagCount())
+		assert.Len(t, 2*frozenMaxDepth, set.TagGetAll())
+		assert.Nil(t, set.TagGet("A"))
+		assert.Equal(t, 7, set.TagGet("7").TagValue())
+	})
+
+	t.Run("flatten removes tags", func(t *testing.T) {
+		// --- Given ---
+		set := NewFrozenTagSet(tstInt("A", 0), tstInt("B", 0))
+		for set.depth < frozenMaxDepth-1 {
+			set = set.With(tstInt("B", set.depth+1))
+		}
+
+		// --- When ---
+		have := set.Without("A")
+
+		// --- Then ---
+		assert.Nil(t, have.parent)
+		assert.Equal(t, 0, have.depth)
+		assert.Equal(t, 1, have.TagCount())
+		assert.Equal(t, frozenMaxDepth-1, have.TagGet("B").TagValue())
+	})
+}
+
+func Test_FrozenTagSet_concurrent(t *testing.T) {
+	// --- Given ---
+	set := NewFrozenTagSet(tstInts("A", 1))
+	wg := sync.WaitGroup{}
+
+	// --- When ---
+	for i := range 10 {
+		wg.Go(func() {
+			for j := range 100 {
+				tag := set.TagGet("A").(*Slice[int])
+				tag.Get()[0] = i
+				drv := set.With(tstInt(strconv.Itoa(j), j)).Without("A")
+				if drv.TagCount() != 1 {
+					t.Error("expected one tag")
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	// --- Then ---
+	assert.Equal(t, []int{1}, set.TagGet("A").TagValue())
+}
@@ -47,3 +47,9 @@ type Metadata interface {
 	// does not exist, the method has no effect.
 	MetaDelete(name string)
 }
+
+// MetaManager is an interface for managing all metadata in the set.
+type MetaManager interface {
+	Metadata
+	MetaAllGetter
+}
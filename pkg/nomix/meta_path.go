@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// maxPathIndex is the maximal slice index supported in paths.
+const maxPathIndex = 1<<16 - 1
+
+// IndexFormat represents the way slice indexes are represented in paths.
+type IndexFormat uint8
+
+// Slice index representations.
+const (
+	// IndexBrackets represents indexes in brackets, like "items[0].name".
+	// Bracketed indexes address only slices.
+	IndexBrackets IndexFormat = iota
+
+	// IndexSeparated represents indexes as path segments, like
+	// "items.0.name". Numeric segments address slices, but are used as keys
+	// of maps.
+	IndexSeparated
+)
+
+// pathElem represents a single element of the path.
+type pathElem struct {
+	key   string // Map key, empty for bracketed indexes.
+	idx   int    // Slice index.
+	index bool   // Set when the element is a slice index.
+}
+
+// Paths below address values in nested map[string]any and []any values. Path
+// segments are separated with the [Options.PathSeparator], and slice indexes
+// are represented according to the [Options.IndexFormat]. By default, paths
+// look like "owner.team" or "items[0].name". Indexes greater than 65535 and
+// empty separators are not supported.
+
+// MetaGetPath returns the value at the path. Returns an error wrapping
+// [ErrInvFormat] when the path is not valid, [ErrMissing] when the value
+// doesn't exist, and [ErrInvType] when the path goes through a value which
+// is not a map or a slice.
+func (set MetaSet) MetaGetPath(path string, opts ...Option) (any, error) {
+	elems, err := parsePath(path, NewOptions(opts...))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	var cur any = set.m
+	for _, elem := range elems {
+		var ok bool
+		switch v := cur.(type) {
+		case map[string]any:
+			if elem.key == "" {
+				return nil, fmt.Errorf("%s: %w", path, ErrInvType)
+			}
+			cur, ok = v[elem.key]
+		case []any:
+			if !elem.index {
+				return nil, fmt.Errorf("%s: %w", path, ErrInvType)
+			}
+			if ok = elem.idx < len(v); ok {
+				cur = v[elem.idx]
+			}
+		default:
+			return nil, fmt.Errorf("%s: %w", path, ErrInvType)
+		}
+		if !ok || cur == nil {
+			return nil, fmt.Errorf("%s: %w", path, ErrMissing)
+		}
+	}
+	return cur, nil
+}
+
+// MetaSetPath sets the value at the path. Missing maps and slices on the
+// path are created, and slices are grown when needed. The nil values are
+// ignored. Returns an error wrapping [ErrInvFormat] when the path is not
+// valid, and [ErrInvType] when the path goes through a value which is not a
+// map or a slice.
+func (set MetaSet) MetaSetPath(path string, value any, opts ...Option) error {
+	if value == nil {
+		return nil
+	}
+	elems, err := parsePath(path, NewOptions(opts...))
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if _, err = pathSet(set.m, elems, value); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// Flatten converts the nested document to the [MetaSet] with values named
+// by their paths. Only map[string]any and []any values are traversed, empty
+// ones are set as they are. The nil values are ignored.
+func Flatten(doc map[string]any, opts ...Option) MetaSet {
+	ops := NewOptions(opts...)
+	set := NewMetaSet()
+	for key, val := range doc {
+		flatten(set, key, val, ops)
+	}
+	return set
+}
+
+// Unflatten converts the [MetaSet] with values named by their paths to the
+// nested document. It is the reverse of [Flatten]. Values are set in the
+// order of their sorted names, with gaps in slices filled with nils.
+//
+// Returns [FieldErrors] with errors of the names which are not valid paths
+// or conflict with other names, like "a" and "a.b".
+func Unflatten(set MetaSet, opts ...Option) (map[string]any, error) {
+	ops := NewOptions(opts...)
+	doc := make(map[string]any, len(set.m))
+	errs := make(map[string]error)
+	for _, name := range slices.Sorted(maps.Keys(set.m)) {
+		elems, err := parsePath(name, ops)
+		if err == nil {
+			_, err = pathSet(doc, elems, set.m[name])
+		}
+		if err != nil {
+			errs[name] = err
+		}
+	}
+	if len(errs) > 0 {
+		return nil, NewFieldErrors(errs)
+	}
+	return doc, nil
+}
+
+// flatten sets the value in the set. Values of non-empty maps and slices
+// are set with names prefixed with the name.
+func flatten(set MetaSet, name string, val any, opts Options) {
+	switch v := val.(type) {
+	case map[string]any:
+		if len(v) > 0 {
+			for key, val := range v {
+				flatten(set, name+opts.PathSeparator+key, val, opts)
+			}
+			return
+		}
+	case []any:
+		if len(v) > 0 {
+			for i, val := range v {
+				flatten(set, pathIndex(name, i, opts), val, opts)
+			}
+			return
+		}
+	}
+	set.MetaSet(name, val)
+}
+
+// pathIndex returns the path of the element of the slice with the name.
+func pathIndex(name string, idx int, opts Options) string {
+	if opts.IndexFormat == IndexSeparated {
+		return name + opts.PathSeparator + strconv.Itoa(idx)
+	}
+	return name + "[" + strconv.Itoa(idx) + "]"
+}
+
+// pathSet sets the value at the path in the container. Returns the
+// container, which is a new one when the passed one is nil or when it is a
+// slice which had to be grown.
+func pathSet(cur any, elems []pathElem, val any) (any, error) {
+	elem := elems[0]
+	if cur == nil {
+		if elem.index {
+			cur = []any{}
+		} else {
+			cur = map[string]any{}
+		}
+	}
+	switch v := cur.(type) {
+	case map[string]any:
+		if elem.key == "" {
+			return nil, ErrInvType
+		}
+		if len(elems) == 1 {
+			v[elem.key] = val
+			return v, nil
+		}
+		nv, err := pathSet(v[elem.key], elems[1:], val)
+		if err != nil {
+			return nil, err
+		}
+		v[elem.key] = nv
+		return v, nil
+
+	case []any:
+		if !elem.index {
+			return nil, ErrInvType
+		}
+		if elem.idx >= len(v) {
+			v = append(v, make([]any, elem.idx-len(v)+1)...)
+		}
+		if len(elems) == 1 {
+			v[elem.idx] = val
+			return v, nil
+		}
+		nv, err := pathSet(v[elem.idx], elems[1:], val)
+		if err != nil {
+			return nil, err
+		}
+		v[elem.idx] = nv
+		return v, nil
+	}
+	return nil, ErrInvType
+}
+
+// parsePath parses the path to its elements. Returns [ErrInvFormat] if the
+// path is not valid or the [Options.PathSeparator] is empty.
+func parsePath(path string, opts Options) ([]pathElem, error) {
+	if opts.PathSeparator == "" {
+		return nil, ErrInvFormat
+	}
+	var elems []pathElem
+	for _, seg := range strings.Split(path, opts.PathSeparator) {
+		if opts.IndexFormat == IndexSeparated {
+			if seg == "" {
+				return nil, ErrInvFormat
+			}
+			if elem, ok := indexElem(seg); ok {
+				elems = append(elems, elem)
+				continue
+			}
+			elems = append(elems, pathElem{key: seg})
+			continue
+		}
+
+		name, idxs, found := strings.Cut(seg, "[")
+		if name == "" || strings.Contains(name, "]") {
+			return nil, ErrInvFormat
+		}
+		elems = append(elems, pathElem{key: name})
+		if !found {
+			continue
+		}
+		for idxs = "[" + idxs; idxs != ""; {
+			end := strings.IndexByte(idxs, ']')
+			if idxs[0] != '[' || end < 0 {
+				return nil, ErrInvFormat
+			}
+			elem, ok := indexElem(idxs[1:end])
+			if !ok {
+				return nil, ErrInvFormat
+			}
+			elem.key = ""
+			elems = append(elems, elem)
+			idxs = idxs[end+1:]
+		}
+	}
+	return elems, nil
+}
+
+// indexElem returns the slice index path element when the string is a
+// canonical representation of a supported index.
+func indexElem(s string) (pathElem, bool) {
+	idx, err := strconv.Atoi(s)
+	if err != nil || idx < 0 || idx > maxPathIndex || strconv.Itoa(idx) != s {
+		return pathElem{}, false
+	}
+	return pathElem{key: s, idx: idx, index: true}, true
+}
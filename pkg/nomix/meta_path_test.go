@@ -0,0 +1,453 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// tstPathDoc returns the nested document used in path tests.
+func tstPathDoc() map[string]any {
+	return map[string]any{
+		"owner": map[string]any{"team": "core", "id": 42},
+		"items": []any{
+			map[string]any{"name": "a"},
+			[]any{1, 2},
+		},
+		"env": "prod",
+	}
+}
+
+func Test_MetaSet_MetaGetPath_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		path string
+		opts []Option
+		exp  any
+	}{
+		{"top level", "env", nil, "prod"},
+		{"nested map", "owner.team", nil, "core"},
+		{"slice element", "items[0].name", nil, "a"},
+		{"nested slice", "items[1][1]", nil, 2},
+		{"map value", "items[0]", nil, map[string]any{"name": "a"}},
+		{
+			"custom separator",
+			"owner/team",
+			[]Option{WithPathSeparator("/")},
+			"core",
+		},
+		{
+			"separated indexes",
+			"items.1.0",
+			[]Option{WithIndexFormat(IndexSeparated)},
+			1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			set := NewMetaSet(WithMeta(tstPathDoc()))
+
+			// --- When ---
+			have, err := set.MetaGetPath(tc.path, tc.opts...)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_MetaSet_MetaGetPath_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		path string
+		opts []Option
+		err  error
+		exp  string
+	}{
+		{
+			"missing key",
+			"owner.name",
+			nil,
+			ErrMissing,
+			"owner.name: missing element",
+		},
+		{
+			"missing index",
+			"items[2]",
+			nil,
+			ErrMissing,
+			"items[2]: missing element",
+		},
+		{
+			"not a container",
+			"env.name",
+			nil,
+			ErrInvType,
+			"env.name: invalid element type",
+		},
+		{
+			"key on slice",
+			"items.name",
+			nil,
+			ErrInvType,
+			"items.name: invalid element type",
+		},
+		{
+			"empty segment",
+			"owner..team",
+			nil,
+			ErrInvFormat,
+			"owner..team: invalid element format",
+		},
+		{
+			"invalid index",
+			"items[a]",
+			nil,
+			ErrInvFormat,
+			"items[a]: invalid element format",
+		},
+		{
+			"negative index",
+			"items[-1]",
+			nil,
+			ErrInvFormat,
+			"items[-1]: invalid element format",
+		},
+		{
+			"not closed index",
+			"items[0",
+			nil,
+			ErrInvFormat,
+			"items[0: invalid element format",
+		},
+		{
+			"index without name",
+			"[0]",
+			nil,
+			ErrInvFormat,
+			"[0]: invalid element format",
+		},
+		{
+			"text after index",
+			"items[0]a",
+			nil,
+			ErrInvFormat,
+			"items[0]a: invalid element format",
+		},
+		{
+			"empty separator",
+			"owner.team",
+			[]Option{WithPathSeparator("")},
+			ErrInvFormat,
+			"owner.team: invalid element format",
+		},
+		{
+			"index on map",
+			"owner[0]",
+			nil,
+			ErrInvType,
+			"owner[0]: invalid element type",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			set := NewMetaSet(WithMeta(tstPathDoc()))
+
+			// --- When ---
+			have, err := set.MetaGetPath(tc.path, tc.opts...)
+
+			// --- Then ---
+			assert.ErrorIs(t, tc.err, err)
+			assert.ErrorEqual(t, tc.exp, err)
+			assert.Nil(t, have)
+		})
+	}
+}
+
+func Test_MetaSet_MetaSetPath(t *testing.T) {
+	t.Run("top level", func(t *testing.T) {
+		// --- Given ---
+		set := NewMetaSet()
+
+		// --- When ---
+		err := set.MetaSetPath("env", "prod")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"env": "prod"}, set.m)
+	})
+
+	t.Run("creates containers", func(t *testing.T) {
+		// --- Given ---
+		set := NewMetaSet()
+
+		// --- When ---
+		err := set.MetaSetPath("owner.teams[1].name", "core")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := map[string]any{
+			"owner": map[string]any{
+				"teams": []any{nil, map[string]any{"name": "core"}},
+			},
+		}
+		assert.Equal(t, want, set.m)
+	})
+
+	t.Run("existing containers", func(t *testing.T) {
+		// --- Given ---
+		set := NewMetaSet(WithMeta(tstPathDoc()))
+
+		// --- When ---
+		err := set.MetaSetPath("items[1][2]", 3)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []any{1, 2, 3}, set.m["items"].([]any)[1])
+		assert.Equal(t, "core", set.m["owner"].(map[string]any)["team"])
+	})
+
+	t.Run("overwrite", func(t *testing.T) {
+		// --- Given ---
+		set := NewMetaSet(WithMeta(tstPathDoc()))
+
+		// --- When ---
+		err := set.MetaSetPath("owner.team", "sre")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := map[string]any{"team": "sre", "id": 42}
+		assert.Equal(t, want, set.m["owner"])
+	})
+
+	t.Run("separated indexes", func(t *testing.T) {
+		// --- Given ---
+		set := NewMetaSet()
+		opts := []Option{
+			WithPathSeparator("/"),
+			WithIndexFormat(IndexSeparated),
+		}
+
+		// --- When ---
+		err := set.MetaSetPath("items/0/name", "a", opts...)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := map[string]any{
+			"items": []any{map[string]any{"name": "a"}},
+		}
+		assert.Equal(t, want, set.m)
+	})
+
+	t.Run("nil value is ignored", func(t *testing.T) {
+		// --- Given ---
+		set := NewMetaSet()
+
+		// --- When ---
+		err := set.MetaSetPath("owner.team", nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 0, set.m)
+	})
+
+	t.Run("error - invalid path", func(t *testing.T) {
+		// --- Given ---
+		set := NewMetaSet()
+
+		// --- When ---
+		err := set.MetaSetPath("owner.", "core")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.ErrorEqual(t, "owner.: invalid element format", err)
+		assert.Len(t, 0, set.m)
+	})
+
+	t.Run("error - empty separator", func(t *testing.T) {
+		// --- Given ---
+		set := NewMetaSet()
+
+		// --- When ---
+		err := set.MetaSetPath("owner", "core", WithPathSeparator(""))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.ErrorEqual(t, "owner: invalid element format", err)
+		assert.Len(t, 0, set.m)
+	})
+
+	t.Run("error - index too big", func(t *testing.T) {
+		// --- Given ---
+		set := NewMetaSet()
+
+		// --- When ---
+		err := set.MetaSetPath("items[65536]", 1)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+	})
+
+	t.Run("error - not a container", func(t *testing.T) {
+		// --- Given ---
+		set := NewMetaSet(WithMeta(tstPathDoc()))
+
+		// --- When ---
+		err := set.MetaSetPath("env.name", "a")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.ErrorEqual(t, "env.name: invalid element type", err)
+		assert.Equal(t, "prod", set.m["env"])
+	})
+
+	t.Run("error - key on slice", func(t *testing.T) {
+		// --- Given ---
+		set := NewMetaSet(WithMeta(tstPathDoc()))
+
+		// --- When ---
+		err := set.MetaSetPath("items.name", "a")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+	})
+}
+
+func Test_Flatten(t *testing.T) {
+	t.Run("brackets", func(t *testing.T) {
+		// --- When ---
+		have := Flatten(tstPathDoc())
+
+		// --- Then ---
+		want := map[string]any{
+			"owner.team":    "core",
+			"owner.id":      42,
+			"items[0].name": "a",
+			"items[1][0]":   1,
+			"items[1][1]":   2,
+			"env":           "prod",
+		}
+		assert.Equal(t, want, have.MetaGetAll())
+	})
+
+	t.Run("separated indexes", func(t *testing.T) {
+		// --- Given ---
+		opts := []Option{
+			WithPathSeparator("/"),
+			WithIndexFormat(IndexSeparated),
+		}
+
+		// --- When ---
+		have := Flatten(tstPathDoc(), opts...)
+
+		// --- Then ---
+		want := map[string]any{
+			"owner/team":   "core",
+			"owner/id":     42,
+			"items/0/name": "a",
+			"items/1/0":    1,
+			"items/1/1":    2,
+			"env":          "prod",
+		}
+		assert.Equal(t, want, have.MetaGetAll())
+	})
+
+	t.Run("empty containers and nil values", func(t *testing.T) {
+		// --- Given ---
+		doc := map[string]any{
+			"a": map[string]any{},
+			"b": []any{},
+			"c": nil,
+			"d": []any{nil, 1},
+		}
+
+		// --- When ---
+		have := Flatten(doc)
+
+		// --- Then ---
+		want := map[string]any{
+			"a":    map[string]any{},
+			"b":    []any{},
+			"d[1]": 1,
+		}
+		assert.Equal(t, want, have.MetaGetAll())
+	})
+}
+
+func Test_Unflatten(t *testing.T) {
+	t.Run("brackets", func(t *testing.T) {
+		// --- Given ---
+		set := Flatten(tstPathDoc())
+
+		// --- When ---
+		have, err := Unflatten(set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, tstPathDoc(), have)
+	})
+
+	t.Run("separated indexes", func(t *testing.T) {
+		// --- Given ---
+		opts := []Option{
+			WithPathSeparator("/"),
+			WithIndexFormat(IndexSeparated),
+		}
+		set := Flatten(tstPathDoc(), opts...)
+
+		// --- When ---
+		have, err := Unflatten(set, opts...)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, tstPathDoc(), have)
+	})
+
+	t.Run("gaps in slices", func(t *testing.T) {
+		// --- Given ---
+		set := NewMetaSet(WithMeta(map[string]any{"a[2]": 2, "a[10]": 10}))
+
+		// --- When ---
+		have, err := Unflatten(set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := make([]any, 11)
+		want[2], want[10] = 2, 10
+		assert.Equal(t, map[string]any{"a": want}, have)
+	})
+
+	t.Run("error - collects errors per name", func(t *testing.T) {
+		// --- Given ---
+		set := NewMetaSet(WithMeta(map[string]any{
+			"a":     1,
+			"a.b":   2,
+			"c..d":  3,
+			"e[0]":  4,
+			"e.f":   5,
+			"g.h":   6,
+			"g.i.j": 7,
+		}))
+
+		// --- When ---
+		have, err := Unflatten(set)
+
+		// --- Then ---
+		fields := tstFieldErrors(t, err)
+		assert.Len(t, 3, fields)
+		assert.ErrorIs(t, ErrInvType, fields["a.b"])
+		assert.ErrorIs(t, ErrInvFormat, fields["c..d"])
+		assert.ErrorIs(t, ErrInvType, fields["e[0]"])
+		assert.Nil(t, have)
+	})
+}
@@ -3,7 +3,20 @@
 
 package nomix
 
-var _ Metadata = MetaSet{} // Compile time check.
+import (
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Compile time checks.
+var (
+	_ Metadata       = MetaSet{}
+	_ MetaAllSetter  = MetaSet{}
+	_ MetaFromGetter = MetaSet{}
+	_ MetaAppender   = MetaSet{}
+)
 
 // MetaSet represents a set of metadata key-values.
 type MetaSet struct{ m map[string]any }
@@ -43,9 +56,56 @@ func (set MetaSet) MetaCount() int { return len(set.m) }
 
 func (set MetaSet) MetaGetAll() map[string]any { return set.m }
 
+// MetaSetAll sets all the values from the map. If the value with the given
+// name already exists in the set, it will be overwritten. The nil values are
+// ignored.
+func (set MetaSet) MetaSetAll(m map[string]any) {
+	for key, val := range m {
+		set.MetaSet(key, val)
+	}
+}
+
+// MetaSetFrom sets all the values from the source the same way as
+// [MetaSet.MetaSetAll].
+func (set MetaSet) MetaSetFrom(src MetaAllGetter) {
+	set.MetaSetAll(src.MetaGetAll())
+}
+
+// MetaAppend appends all the values in the set to the map.
+func (set MetaSet) MetaAppend(m map[string]any) {
+	maps.Copy(m, set.m)
+}
+
 // MetaDeleteAll deletes all metadata from the set.
 func (set MetaSet) MetaDeleteAll() {
 	for name := range set.m {
 		delete(set.m, name)
 	}
 }
+
+// MetaAll returns an iterator over all metadata in the set. The iteration
+// order is not specified.
+func (set MetaSet) MetaAll() iter.Seq2[string, any] {
+	return maps.All(set.m)
+}
+
+// MetaSorted returns an iterator over all metadata in the set sorted by name.
+func (set MetaSet) MetaSorted() iter.Seq2[string, any] {
+	return set.MetaByPrefix("")
+}
+
+// MetaByPrefix returns an iterator over metadata with names starting with
+// the given prefix sorted by name.
+func (set MetaSet) MetaByPrefix(prefix string) iter.Seq2[string, any] {
+	return func(yield func(string, any) bool) {
+		for _, name := range slices.Sorted(maps.Keys(set.m)) {
+			val := set.m[name]
+			if val == nil || !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			if !yield(name, val) {
+				return
+			}
+		}
+	}
+}
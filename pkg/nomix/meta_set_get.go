@@ -0,0 +1,297 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// The getters below coerce metadata values to the requested types. They
+// return errors wrapping [ErrMissing] when the key doesn't exist, [ErrInvType]
+// when the value's type cannot be coerced, and [ErrInvFormat] when the string
+// representation of the value is not valid. Numeric getters support
+// [json.Number] values. Slice getters coerce slices of any type, like []any
+// decoded from JSON, element by element.
+
+// MetaGetString returns the string value of the key.
+func (set MetaSet) MetaGetString(key string) (string, error) {
+	return metaGet(set, key, nil, castString)
+}
+
+// MetaGetInt returns the value of the key as int. Integer values, floats
+// without a fractional part, and strings in the base set by the [Options.Radix]
+// are supported.
+func (set MetaSet) MetaGetInt(key string, opts ...Option) (int, error) {
+	return metaGet(set, key, opts, castInt)
+}
+
+// MetaGetInt64 returns the value of the key as int64. The same values as in
+// [MetaSet.MetaGetInt] are supported.
+func (set MetaSet) MetaGetInt64(key string, opts ...Option) (int64, error) {
+	return metaGet(set, key, opts, castInt64)
+}
+
+// MetaGetFloat64 returns the value of the key as float64. The values
+// supported by [CreateFloat64] and strings are supported.
+func (set MetaSet) MetaGetFloat64(key string) (float64, error) {
+	return metaGet(set, key, nil, castFloat64)
+}
+
+// MetaGetBool returns the value of the key as bool. Boolean values and
+// strings accepted by [strconv.ParseBool] are supported.
+func (set MetaSet) MetaGetBool(key string) (bool, error) {
+	return metaGet(set, key, nil, castBool)
+}
+
+// MetaGetTime returns the value of the key as [time.Time]. Strings are
+// parsed with [CreateTime] using the [Options.TimeFormat], [Options.Location]
+// and zero time values set by the [WithZeroTime] option.
+func (set MetaSet) MetaGetTime(key string, opts ...Option) (time.Time, error) {
+	return metaGet(set, key, opts, CreateTime)
+}
+
+// MetaGetLoc returns the value of the key as [time.Location]. With the
+// [WithLocString] option, timezone names, like "Europe/Warsaw", are
+// supported.
+func (set MetaSet) MetaGetLoc(
+	key string,
+	opts ...Option,
+) (*time.Location, error) {
+
+	return metaGet(set, key, opts, castLoc)
+}
+
+// MetaGetDuration returns the value of the key as [time.Duration]. Integer
+// values representing nanoseconds, including floats without a fractional
+// part and [json.Number] values, and strings accepted by
+// [time.ParseDuration] are supported.
+func (set MetaSet) MetaGetDuration(key string) (time.Duration, error) {
+	return metaGet(set, key, nil, castDuration)
+}
+
+// MetaGetStringSlice returns the value of the key as []string.
+func (set MetaSet) MetaGetStringSlice(key string) ([]string, error) {
+	return metaGetSlice(set, key, nil, castString)
+}
+
+// MetaGetIntSlice returns the value of the key as []int. Elements are
+// coerced the same way as in [MetaSet.MetaGetInt].
+func (set MetaSet) MetaGetIntSlice(key string, opts ...Option) ([]int, error) {
+	return metaGetSlice(set, key, opts, castInt)
+}
+
+// MetaGetInt64Slice returns the value of the key as []int64. Elements are
+// coerced the same way as in [MetaSet.MetaGetInt64].
+func (set MetaSet) MetaGetInt64Slice(
+	key string,
+	opts ...Option,
+) ([]int64, error) {
+
+	return metaGetSlice(set, key, opts, castInt64)
+}
+
+// MetaGetFloat64Slice returns the value of the key as []float64. Elements
+// are coerced the same way as in [MetaSet.MetaGetFloat64].
+func (set MetaSet) MetaGetFloat64Slice(key string) ([]float64, error) {
+	return metaGetSlice(set, key, nil, castFloat64)
+}
+
+// MetaGetBoolSlice returns the value of the key as []bool. Elements are
+// coerced the same way as in [MetaSet.MetaGetBool].
+func (set MetaSet) MetaGetBoolSlice(key string) ([]bool, error) {
+	return metaGetSlice(set, key, nil, castBool)
+}
+
+// MetaGetTimeSlice returns the value of the key as []time.Time. Elements are
+// coerced the same way as in [MetaSet.MetaGetTime].
+func (set MetaSet) MetaGetTimeSlice(
+	key string,
+	opts ...Option,
+) ([]time.Time, error) {
+
+	return metaGetSlice(set, key, opts, CreateTime)
+}
+
+// metaGet returns the value of the key coerced with the cast function.
+func metaGet[T any](
+	set MetaSet,
+	key string,
+	opts []Option,
+	cast func(val any, opts Options) (T, error),
+) (T, error) {
+
+	var zero T
+	val := set.m[key]
+	if val == nil {
+		return zero, fmt.Errorf("%s: %w", key, ErrMissing)
+	}
+	v, err := cast(val, NewOptions(opts...))
+	if err != nil {
+		return zero, fmt.Errorf("%s: %w", key, err)
+	}
+	return v, nil
+}
+
+// metaGetSlice returns the value of the key coerced to []T. Elements of the
+// slice are coerced with the cast function.
+func metaGetSlice[T any](
+	set MetaSet,
+	key string,
+	opts []Option,
+	cast func(val any, opts Options) (T, error),
+) ([]T, error) {
+
+	return metaGet(set, key, opts, func(val any, opts Options) ([]T, error) {
+		return castSlice(val, opts, cast)
+	})
+}
+
+// castSlice coerces the value to []T. Elements of the slice are coerced with
+// the cast function.
+func castSlice[T any](
+	val any,
+	opts Options,
+	cast func(val any, opts Options) (T, error),
+) ([]T, error) {
+
+	if v, ok := val.([]T); ok {
+		return v, nil
+	}
+	rv := reflect.ValueOf(val)
+	if rv.Kind() != reflect.Slice {
+		return nil, ErrInvType
+	}
+	vs := make([]T, rv.Len())
+	for i := range rv.Len() {
+		var err error
+		if vs[i], err = cast(rv.Index(i).Interface(), opts); err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+	}
+	return vs, nil
+}
+
+// castString coerces the value to string.
+func castString(val any, _ Options) (string, error) {
+	if v, ok := val.(string); ok {
+		return v, nil
+	}
+	return "", ErrInvType
+}
+
+// castInt coerces the value to int.
+func castInt(val any, opts Options) (int, error) {
+	v, err := castInt64(val, opts)
+	if err != nil {
+		return 0, err
+	}
+	if v < math.MinInt || v > math.MaxInt {
+		return 0, ErrInvValue
+	}
+	return int(v), nil
+}
+
+// castInt64 coerces the value to int64.
+func castInt64(val any, opts Options) (int64, error) {
+	if v, err := CreateInt64(val); err == nil {
+		return v, nil
+	}
+	switch v := val.(type) {
+	case float32:
+		return castInt64(float64(v), opts)
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, ErrInvType
+		}
+		return int64(v), nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return 0, ErrInvFormat
+		}
+		return castInt64(f, opts)
+	case string:
+		i, err := strconv.ParseInt(v, opts.Radix, 64)
+		if err != nil {
+			return 0, ErrInvFormat
+		}
+		return i, nil
+	}
+	return 0, ErrInvType
+}
+
+// castFloat64 coerces the value to float64.
+func castFloat64(val any, opts Options) (float64, error) {
+	switch v := val.(type) {
+	case json.Number:
+		return castFloat64(v.String(), opts)
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, ErrInvFormat
+		}
+		return f, nil
+	}
+	return CreateFloat64(val)
+}
+
+// castBool coerces the value to bool.
+func castBool(val any, _ Options) (bool, error) {
+	switch v := val.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, ErrInvFormat
+		}
+		return b, nil
+	}
+	return false, ErrInvType
+}
+
+// castLoc coerces the value to [time.Location].
+func castLoc(val any, opts Options) (*time.Location, error) {
+	switch v := val.(type) {
+	case *time.Location:
+		return v, nil
+	case string:
+		if !opts.LocationAsString {
+			return nil, ErrInvType
+		}
+		loc, err := time.LoadLocation(v)
+		if err != nil {
+			return nil, ErrInvFormat
+		}
+		return loc, nil
+	}
+	return nil, ErrInvType
+}
+
+// castDuration coerces the value to [time.Duration].
+func castDuration(val any, opts Options) (time.Duration, error) {
+	switch v := val.(type) {
+	case time.Duration:
+		return v, nil
+	case string:
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, ErrInvFormat
+		}
+		return d, nil
+	}
+	v, err := castInt64(val, opts)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(v), nil
+}
@@ -0,0 +1,588 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+// tstMetaGetSet returns the [MetaSet] with a single value for getter tests.
+func tstMetaGetSet(val any) MetaSet {
+	return NewMetaSet(WithMeta(map[string]any{"A": val}))
+}
+
+func Test_MetaSet_MetaGetString(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet("abc").MetaGetString("A")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", have)
+	})
+
+	t.Run("error - missing", func(t *testing.T) {
+		// --- When ---
+		have, err := NewMetaSet().MetaGetString("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.ErrorEqual(t, "A: missing element", err)
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet(1).MetaGetString("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.ErrorEqual(t, "A: invalid element type", err)
+		assert.Equal(t, "", have)
+	})
+}
+
+func Test_MetaSet_MetaGetInt_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val  any
+		opts []Option
+		exp  int
+	}{
+		{"int", 42, nil, 42},
+		{"byte", byte(42), nil, 42},
+		{"int8", int8(-42), nil, -42},
+		{"int64", int64(42), nil, 42},
+		{"float64", float64(42), nil, 42},
+		{"float32", float32(-42), nil, -42},
+		{"string", "42", nil, 42},
+		{"hex string", "2A", []Option{WithRadixHEX}, 42},
+		{"json number", json.Number("42"), nil, 42},
+		{"json number float", json.Number("42.0"), nil, 42},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := tstMetaGetSet(tc.val).MetaGetInt("A", tc.opts...)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_MetaSet_MetaGetInt_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val any
+		exp error
+	}{
+		{"fraction", 4.2, ErrInvType},
+		{"float out of range", 1e20, ErrInvType},
+		{"invalid string", "4.2", ErrInvFormat},
+		{"bool", true, ErrInvType},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := tstMetaGetSet(tc.val).MetaGetInt("A")
+
+			// --- Then ---
+			assert.ErrorIs(t, tc.exp, err)
+			assert.Equal(t, 0, have)
+		})
+	}
+}
+
+func Test_MetaSet_MetaGetInt64(t *testing.T) {
+	t.Run("int64", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet(int32(42)).MetaGetInt64("A")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), have)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet("-42").MetaGetInt64("A")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-42), have)
+	})
+
+	t.Run("error - missing", func(t *testing.T) {
+		// --- When ---
+		have, err := NewMetaSet().MetaGetInt64("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Equal(t, int64(0), have)
+	})
+}
+
+func Test_MetaSet_MetaGetFloat64_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val any
+		exp float64
+	}{
+		{"float64", 4.2, 4.2},
+		{"float32", float32(0.5), 0.5},
+		{"int", 42, 42},
+		{"string", "4.2", 4.2},
+		{"json number", json.Number("4.2"), 4.2},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := tstMetaGetSet(tc.val).MetaGetFloat64("A")
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_MetaSet_MetaGetFloat64(t *testing.T) {
+	t.Run("error - invalid string", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet("abc").MetaGetFloat64("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Equal(t, 0.0, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet(true).MetaGetFloat64("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Equal(t, 0.0, have)
+	})
+}
+
+func Test_MetaSet_MetaGetBool(t *testing.T) {
+	t.Run("bool", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet(true).MetaGetBool("A")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, have)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet("true").MetaGetBool("A")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, have)
+	})
+
+	t.Run("error - invalid string", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet("yes").MetaGetBool("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.False(t, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet(1).MetaGetBool("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.False(t, have)
+	})
+}
+
+func Test_MetaSet_MetaGetTime(t *testing.T) {
+	t.Run("time", func(t *testing.T) {
+		// --- Given ---
+		tim := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		// --- When ---
+		have, err := tstMetaGetSet(tim).MetaGetTime("A")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, tim, have)
+	})
+
+	t.Run("string with default format", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet("2026-01-02T03:04:05Z").MetaGetTime("A")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), have)
+	})
+
+	t.Run("string with format and location", func(t *testing.T) {
+		// --- Given ---
+		set := tstMetaGetSet("2026-01-02 03:04")
+		loc := must.Value(time.LoadLocation("Europe/Warsaw"))
+
+		// --- When ---
+		have, err := set.MetaGetTime(
+			"A",
+			WithTimeFormat("2006-01-02 15:04"),
+			WithTimeLoc(loc),
+		)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 1, 2, 3, 4, 0, 0, loc), have)
+	})
+
+	t.Run("zero time string", func(t *testing.T) {
+		// --- Given ---
+		set := tstMetaGetSet("0000-00-00T00:00:00")
+
+		// --- When ---
+		have, err := set.MetaGetTime("A", WithZeroTime("0000-00-00T00:00:00"))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Zero(t, have)
+	})
+
+	t.Run("error - invalid string", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet("abc").MetaGetTime("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Zero(t, have)
+	})
+
+	t.Run("error - string without format", func(t *testing.T) {
+		// --- Given ---
+		set := tstMetaGetSet("2026-01-02T03:04:05Z")
+
+		// --- When ---
+		have, err := set.MetaGetTime("A", WithTimeFormat(""))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Zero(t, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet(1).MetaGetTime("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Zero(t, have)
+	})
+}
+
+func Test_MetaSet_MetaGetLoc(t *testing.T) {
+	t.Run("location", func(t *testing.T) {
+		// --- Given ---
+		loc := must.Value(time.LoadLocation("Europe/Warsaw"))
+
+		// --- When ---
+		have, err := tstMetaGetSet(loc).MetaGetLoc("A")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Same(t, loc, have)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		// --- Given ---
+		set := tstMetaGetSet("Europe/Warsaw")
+
+		// --- When ---
+		have, err := set.MetaGetLoc("A", WithLocString)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "Europe/Warsaw", have.String())
+	})
+
+	t.Run("error - string not allowed", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet("Europe/Warsaw").MetaGetLoc("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid string", func(t *testing.T) {
+		// --- Given ---
+		set := tstMetaGetSet("Europe/Unknown")
+
+		// --- When ---
+		have, err := set.MetaGetLoc("A", WithLocString)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet(1).MetaGetLoc("A", WithLocString)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_MetaSet_MetaGetDuration_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val any
+		exp time.Duration
+	}{
+		{"duration", time.Second, time.Second},
+		{"string", "1m30s", 90 * time.Second},
+		{"int", 1000, time.Microsecond},
+		{"int64", int64(1), time.Nanosecond},
+		{"integral float64", 1000.0, time.Microsecond},
+		{"json number", json.Number("1000"), time.Microsecond},
+		{"json number with exponent", json.Number("1e3"), time.Microsecond},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := tstMetaGetSet(tc.val).MetaGetDuration("A")
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_MetaSet_MetaGetDuration(t *testing.T) {
+	t.Run("error - invalid string", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet("abc").MetaGetDuration("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Equal(t, time.Duration(0), have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet(1.5).MetaGetDuration("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Equal(t, time.Duration(0), have)
+	})
+
+	t.Run("error - invalid json number", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet(json.Number("1.5")).MetaGetDuration("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Equal(t, time.Duration(0), have)
+	})
+}
+
+func Test_MetaSet_MetaGetStringSlice(t *testing.T) {
+	t.Run("string slice", func(t *testing.T) {
+		// --- Given ---
+		val := []string{"a", "b"}
+
+		// --- When ---
+		have, err := tstMetaGetSet(val).MetaGetStringSlice("A")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Same(t, val, have)
+	})
+
+	t.Run("any slice", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet([]any{"a", "b"}).MetaGetStringSlice("A")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, have)
+	})
+
+	t.Run("error - invalid element", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet([]any{"a", 1}).MetaGetStringSlice("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.ErrorEqual(t, "A: 1: invalid element type", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - not a slice", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet("a").MetaGetStringSlice("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - missing", func(t *testing.T) {
+		// --- When ---
+		have, err := NewMetaSet().MetaGetStringSlice("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_MetaSet_MetaGetIntSlice(t *testing.T) {
+	t.Run("int slice", func(t *testing.T) {
+		// --- Given ---
+		val := []int{1, 2}
+
+		// --- When ---
+		have, err := tstMetaGetSet(val).MetaGetIntSlice("A")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Same(t, val, have)
+	})
+
+	t.Run("coerced elements", func(t *testing.T) {
+		// --- Given ---
+		set := tstMetaGetSet([]any{1, int8(2), 3.0, "A"})
+
+		// --- When ---
+		have, err := set.MetaGetIntSlice("A", WithRadixHEX)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 10}, have)
+	})
+
+	t.Run("error - invalid element", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet([]any{1, "a"}).MetaGetIntSlice("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_MetaSet_MetaGetInt64Slice(t *testing.T) {
+	t.Run("int32 slice", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet([]int32{1, 2}).MetaGetInt64Slice("A")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 2}, have)
+	})
+
+	t.Run("error - invalid element", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet([]any{1.5}).MetaGetInt64Slice("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_MetaSet_MetaGetFloat64Slice(t *testing.T) {
+	t.Run("coerced elements", func(t *testing.T) {
+		// --- Given ---
+		set := tstMetaGetSet([]any{1, float32(0.5), "1.5"})
+
+		// --- When ---
+		have, err := set.MetaGetFloat64Slice("A")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{1, 0.5, 1.5}, have)
+	})
+
+	t.Run("error - invalid element", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet([]any{true}).MetaGetFloat64Slice("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_MetaSet_MetaGetBoolSlice(t *testing.T) {
+	t.Run("coerced elements", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet([]any{true, "0"}).MetaGetBoolSlice("A")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []bool{true, false}, have)
+	})
+
+	t.Run("error - invalid element", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet([]int{1}).MetaGetBoolSlice("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_MetaSet_MetaGetTimeSlice(t *testing.T) {
+	t.Run("string slice", func(t *testing.T) {
+		// --- Given ---
+		set := tstMetaGetSet([]string{"2026-01-02T03:04:05Z", "zero"})
+
+		// --- When ---
+		have, err := set.MetaGetTimeSlice("A", WithZeroTime("zero"))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := []time.Time{time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), {}}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("error - invalid element", func(t *testing.T) {
+		// --- When ---
+		have, err := tstMetaGetSet([]any{"abc"}).MetaGetTimeSlice("A")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.ErrorEqual(t, "A: 0: invalid element format", err)
+		assert.Nil(t, have)
+	})
+}
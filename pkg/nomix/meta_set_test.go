@@ -164,6 +164,66 @@ func Test_MetaSet_MetaGetAll(t *testing.T) {
 	})
 }
 
+func Test_MetaSet_MetaSetAll(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		set := MetaSet{m: map[string]any{"A": 1, "B": 2}}
+
+		// --- When ---
+		set.MetaSetAll(map[string]any{"B": 3, "C": 4})
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": 1, "B": 3, "C": 4}, set.m)
+	})
+
+	t.Run("nil values are ignored", func(t *testing.T) {
+		// --- Given ---
+		set := MetaSet{m: map[string]any{"A": 1}}
+
+		// --- When ---
+		set.MetaSetAll(map[string]any{"A": nil, "B": nil})
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": 1}, set.m)
+	})
+
+	t.Run("nil map", func(t *testing.T) {
+		// --- Given ---
+		set := MetaSet{m: map[string]any{"A": 1}}
+
+		// --- When ---
+		set.MetaSetAll(nil)
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": 1}, set.m)
+	})
+}
+
+func Test_MetaSet_MetaSetFrom(t *testing.T) {
+	// --- Given ---
+	set := MetaSet{m: map[string]any{"A": 1}}
+	src := MetaSet{m: map[string]any{"A": 2, "B": nil, "C": 3}}
+
+	// --- When ---
+	set.MetaSetFrom(src)
+
+	// --- Then ---
+	assert.Equal(t, map[string]any{"A": 2, "C": 3}, set.m)
+}
+
+func Test_MetaSet_MetaAppend(t *testing.T) {
+	// --- Given ---
+	set := MetaSet{m: map[string]any{"A": 1, "B": 2}}
+	m := map[string]any{"B": 0, "C": 3}
+
+	// --- When ---
+	set.MetaAppend(m)
+
+	// --- Then ---
+	assert.Equal(t, map[string]any{"A": 1, "B": 2, "C": 3}, m)
+	assert.Equal(t, map[string]any{"A": 1, "B": 2}, set.m)
+}
+
 func Test_MetaSet_MetaDeleteAll(t *testing.T) {
 	// --- Given ---
 	set := MetaSet{m: map[string]any{"A": 1, "B": 2}}
@@ -174,3 +234,85 @@ func Test_MetaSet_MetaDeleteAll(t *testing.T) {
 	// --- Then ---
 	assert.Len(t, 0, set.m)
 }
+
+func Test_MetaSet_MetaAll(t *testing.T) {
+	t.Run("all", func(t *testing.T) {
+		// --- Given ---
+		set := NewMetaSet(WithMeta(map[string]any{"A": 1, "B": "b"}))
+
+		// --- When ---
+		have := make(map[string]any)
+		for name, val := range set.MetaAll() {
+			have[name] = val
+		}
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": 1, "B": "b"}, have)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		// --- Given ---
+		set := NewMetaSet(WithMeta(map[string]any{"A": 1, "B": "b"}))
+
+		// --- When ---
+		var cnt int
+		for range set.MetaAll() {
+			cnt++
+			break
+		}
+
+		// --- Then ---
+		assert.Equal(t, 1, cnt)
+	})
+}
+
+func Test_MetaSet_MetaSorted(t *testing.T) {
+	t.Run("sorted", func(t *testing.T) {
+		// --- Given ---
+		m := map[string]any{"C": 3, "A": 1, "B": "b"}
+		set := NewMetaSet(WithMeta(m))
+
+		// --- When ---
+		var names []string
+		var values []any
+		for name, val := range set.MetaSorted() {
+			names = append(names, name)
+			values = append(values, val)
+		}
+
+		// --- Then ---
+		assert.Equal(t, []string{"A", "B", "C"}, names)
+		assert.Equal(t, []any{1, "b", 3}, values)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		// --- Given ---
+		m := map[string]any{"C": 3, "A": 1, "B": "b"}
+		set := NewMetaSet(WithMeta(m))
+
+		// --- When ---
+		var have []string
+		for name := range set.MetaSorted() {
+			have = append(have, name)
+			break
+		}
+
+		// --- Then ---
+		assert.Equal(t, []string{"A"}, have)
+	})
+}
+
+func Test_MetaSet_MetaByPrefix(t *testing.T) {
+	// --- Given ---
+	m := map[string]any{"app.b": 2, "app.a": 1, "apps": 3, "env": 4}
+	set := NewMetaSet(WithMeta(m))
+
+	// --- When ---
+	var have []string
+	for name := range set.MetaByPrefix("app.") {
+		have = append(have, name)
+	}
+
+	// --- Then ---
+	assert.Equal(t, []string{"app.a", "app.b"}, have)
+}
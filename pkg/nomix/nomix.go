@@ -32,4 +32,14 @@ var (
 	// ErrNotImpl represents a missing method implementation or
 	// functionality for a type.
 	ErrNotImpl = errors.New("not implemented")
+
+	// ErrForbidden represents a set element which is not allowed.
+	ErrForbidden = errors.New("forbidden element")
+
+	// ErrUnknown represents a set element without a definition.
+	ErrUnknown = errors.New("unknown element")
+
+	// ErrConflict represents a set element which doesn't match the expected
+	// state, for example, when it was concurrently modified.
+	ErrConflict = errors.New("conflict")
 )
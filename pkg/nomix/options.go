@@ -53,13 +53,72 @@ type Options struct {
 
 	// The base for integers when parsing.
 	Radix int
+
+	// Element separator.
+	//
+	// Used by slice parsers to split the delimited slice representation.
+	Separator string
+
+	// Element quote character.
+	//
+	// Used by slice parsers to recognize quoted elements in the delimited
+	// slice representation. Quoted elements may contain the separator, and
+	// the quote character itself must be doubled. Zero disables quoting.
+	Quote rune
+
+	// When set, slice parsers trim white space around elements of the
+	// delimited slice representation.
+	Trim bool
+
+	// When set, [MarshalTagSet] uses the verbose JSON representation.
+	Verbose bool
+
+	// When set, [Patch.Apply] checks the tags are in the state the patch was
+	// created for before applying it.
+	Precondition bool
+
+	// When set, [ToMetaSet] represents time values as strings formatted with
+	// the TimeFormat in the Location.
+	TimeAsString bool
+
+	// Representation of byte slices.
+	//
+	// Used by [ToMetaSet] and [ToTagSet] to convert byte slice values. By
+	// default, byte slices are represented as []byte.
+	BytesFormat BytesFormat
+
+	// Path segment separator.
+	//
+	// Used by [MetaSet.MetaGetPath], [MetaSet.MetaSetPath], [Flatten] and
+	// [Unflatten] to split paths to segments. Must not be empty.
+	PathSeparator string
+
+	// Representation of slice indexes in paths.
+	IndexFormat IndexFormat
+
+	// Tag definitions by name.
+	//
+	// Set by [WithSchema] and [WithDefinitions] functions. Used by [ToTagSet]
+	// to pick the tag kinds.
+	defs map[string]*Definition
+
+	// Database encoding of slice tag values.
+	//
+	// Used by slice tags to implement [driver.Valuer] and [sql.Scanner]
+	// interfaces. By default, [JSONArray] is used.
+	SliceEncoder SliceEncoder
 }
 
 // NewOptions returns a new [Options] instance with default values.
 func NewOptions(opts ...Option) Options {
 	o := Options{
-		TimeFormat: time.RFC3339Nano,
-		Radix:      10,
+		TimeFormat:    time.RFC3339Nano,
+		Radix:         10,
+		Separator:     ",",
+		Quote:         '"',
+		Trim:          true,
+		PathSeparator: ".",
+		SliceEncoder:  JSONArray{},
 	}
 	for _, opt := range opts {
 		opt(&o)
@@ -108,3 +167,74 @@ func WithZeroTime(zero ...string) Option {
 
 // WithRadixHEX sets base to hexadecimal when parsing integers.
 func WithRadixHEX(opts *Options) { opts.Radix = 16 }
+
+// WithSeparator sets the element separator used by slice parsers.
+func WithSeparator(sep string) Option {
+	return func(opts *Options) { opts.Separator = sep }
+}
+
+// WithQuote sets the element quote character used by slice parsers. Use zero
+// to disable quoting.
+func WithQuote(quote rune) Option {
+	return func(opts *Options) { opts.Quote = quote }
+}
+
+// WithNoTrim disables trimming white space around slice elements when parsing.
+func WithNoTrim(opts *Options) { opts.Trim = false }
+
+// WithVerbose is the [MarshalTagSet] option selecting the verbose JSON
+// representation.
+func WithVerbose(opts *Options) { opts.Verbose = true }
+
+// WithPrecondition is the [Patch.Apply] option enabling precondition checks.
+func WithPrecondition(opts *Options) { opts.Precondition = true }
+
+// WithSliceEncoder sets the database encoding of slice tag values.
+func WithSliceEncoder(enc SliceEncoder) Option {
+	return func(opts *Options) { opts.SliceEncoder = enc }
+}
+
+// WithTimeString is the [ToMetaSet] option representing time values as
+// strings.
+func WithTimeString(opts *Options) { opts.TimeAsString = true }
+
+// WithBytesFormat sets the representation of byte slices.
+func WithBytesFormat(format BytesFormat) Option {
+	return func(opts *Options) { opts.BytesFormat = format }
+}
+
+// WithSchema is the [ToTagSet] option adding definitions of the schema.
+func WithSchema(sch *Schema) Option {
+	return func(opts *Options) {
+		for _, name := range sch.Names() {
+			addDefinition(opts, sch.Definition(name))
+		}
+	}
+}
+
+// WithDefinitions is the [ToTagSet] option adding the definitions.
+func WithDefinitions(defs ...*Definition) Option {
+	return func(opts *Options) {
+		for _, def := range defs {
+			addDefinition(opts, def)
+		}
+	}
+}
+
+// addDefinition adds the definition to the options.
+func addDefinition(opts *Options, def *Definition) {
+	if opts.defs == nil {
+		opts.defs = make(map[string]*Definition)
+	}
+	opts.defs[def.TagName()] = def
+}
+
+// WithPathSeparator sets the path segment separator.
+func WithPathSeparator(sep string) Option {
+	return func(opts *Options) { opts.PathSeparator = sep }
+}
+
+// WithIndexFormat sets the representation of slice indexes in paths.
+func WithIndexFormat(format IndexFormat) Option {
+	return func(opts *Options) { opts.IndexFormat = format }
+}
@@ -24,7 +24,18 @@ func Test_NewOptions(t *testing.T) {
 		assert.False(t, have.LocationAsString)
 		assert.Empty(t, have.zeroTime)
 		assert.Equal(t, 10, have.Radix)
-		assert.Fields(t, 7, have)
+		assert.Equal(t, ",", have.Separator)
+		assert.Equal(t, '"', have.Quote)
+		assert.True(t, have.Trim)
+		assert.False(t, have.Verbose)
+		assert.False(t, have.Precondition)
+		assert.False(t, have.TimeAsString)
+		assert.Equal(t, BytesRaw, have.BytesFormat)
+		assert.Equal(t, ".", have.PathSeparator)
+		assert.Equal(t, IndexBrackets, have.IndexFormat)
+		assert.Nil(t, have.defs)
+		assert.Equal(t, JSONArray{}, have.SliceEncoder)
+		assert.Fields(t, 18, have)
 	})
 
 	t.Run("with changes", func(t *testing.T) {
@@ -39,7 +50,18 @@ func Test_NewOptions(t *testing.T) {
 		assert.False(t, have.LocationAsString)
 		assert.Empty(t, have.zeroTime)
 		assert.Equal(t, 10, have.Radix)
-		assert.Fields(t, 7, have)
+		assert.Equal(t, ",", have.Separator)
+		assert.Equal(t, '"', have.Quote)
+		assert.True(t, have.Trim)
+		assert.False(t, have.Verbose)
+		assert.False(t, have.Precondition)
+		assert.False(t, have.TimeAsString)
+		assert.Equal(t, BytesRaw, have.BytesFormat)
+		assert.Equal(t, ".", have.PathSeparator)
+		assert.Equal(t, IndexBrackets, have.IndexFormat)
+		assert.Nil(t, have.defs)
+		assert.Equal(t, JSONArray{}, have.SliceEncoder)
+		assert.Fields(t, 18, have)
 	})
 }
 
@@ -133,3 +155,161 @@ func Test_WithRadixHEX(t *testing.T) {
 	// --- Then ---
 	assert.Equal(t, 16, opts.Radix)
 }
+
+func Test_WithSeparator(t *testing.T) {
+	// --- Given ---
+	opts := &Options{}
+
+	// --- When ---
+	WithSeparator(";")(opts)
+
+	// --- Then ---
+	assert.Equal(t, ";", opts.Separator)
+}
+
+func Test_WithQuote(t *testing.T) {
+	// --- Given ---
+	opts := &Options{}
+
+	// --- When ---
+	WithQuote('\'')(opts)
+
+	// --- Then ---
+	assert.Equal(t, '\'', opts.Quote)
+}
+
+func Test_WithNoTrim(t *testing.T) {
+	// --- Given ---
+	opts := &Options{Trim: true}
+
+	// --- When ---
+	WithNoTrim(opts)
+
+	// --- Then ---
+	assert.False(t, opts.Trim)
+}
+
+func Test_WithVerbose(t *testing.T) {
+	// --- Given ---
+	opts := &Options{}
+
+	// --- When ---
+	WithVerbose(opts)
+
+	// --- Then ---
+	assert.True(t, opts.Verbose)
+}
+
+func Test_WithPrecondition(t *testing.T) {
+	// --- Given ---
+	opts := &Options{}
+
+	// --- When ---
+	WithPrecondition(opts)
+
+	// --- Then ---
+	assert.True(t, opts.Precondition)
+}
+
+func Test_WithSliceEncoder(t *testing.T) {
+	// --- Given ---
+	opts := &Options{}
+
+	// --- When ---
+	WithSliceEncoder(PGArray{})(opts)
+
+	// --- Then ---
+	assert.Equal(t, PGArray{}, opts.SliceEncoder)
+}
+
+func Test_WithTimeString(t *testing.T) {
+	// --- Given ---
+	opts := &Options{}
+
+	// --- When ---
+	WithTimeString(opts)
+
+	// --- Then ---
+	assert.True(t, opts.TimeAsString)
+}
+
+func Test_WithBytesFormat(t *testing.T) {
+	// --- Given ---
+	opts := &Options{}
+
+	// --- When ---
+	WithBytesFormat(BytesBase64)(opts)
+
+	// --- Then ---
+	assert.Equal(t, BytesBase64, opts.BytesFormat)
+}
+
+func Test_WithSchema(t *testing.T) {
+	// --- Given ---
+	defA := Define("A", TstIntSpec())
+	defB := Define("B", tstStrSpec())
+	sch := NewSchema("test")
+	sch.Require(defA)
+	sch.Allow(defB)
+	opts := &Options{}
+
+	// --- When ---
+	WithSchema(sch)(opts)
+
+	// --- Then ---
+	want := map[string]*Definition{"A": defA, "B": defB}
+	assert.Equal(t, want, opts.defs)
+}
+
+func Test_WithDefinitions(t *testing.T) {
+	t.Run("add", func(t *testing.T) {
+		// --- Given ---
+		defA := Define("A", TstIntSpec())
+		defB := Define("B", tstStrSpec())
+		opts := &Options{}
+
+		// --- When ---
+		WithDefinitions(defA, defB)(opts)
+
+		// --- Then ---
+		want := map[string]*Definition{"A": defA, "B": defB}
+		assert.Equal(t, want, opts.defs)
+	})
+
+	t.Run("overwrite", func(t *testing.T) {
+		// --- Given ---
+		defA := Define("A", TstIntSpec())
+		defB := Define("A", tstStrSpec())
+		opts := &Options{}
+		WithDefinitions(defA)(opts)
+
+		// --- When ---
+		WithDefinitions(defB)(opts)
+
+		// --- Then ---
+		assert.Len(t, 1, opts.defs)
+		assert.Same(t, defB, opts.defs["A"])
+	})
+}
+
+func Test_WithPathSeparator(t *testing.T) {
+	// --- Given ---
+	opts := &Options{}
+
+	// --- When ---
+	WithPathSeparator("/")(opts)
+
+	// --- Then ---
+	assert.Equal(t, "/", opts.PathSeparator)
+}
+
+func Test_WithIndexFormat(t *testing.T) {
+	// --- Given ---
+	opts := &Options{}
+
+	// --- When ---
+	WithIndexFormat(IndexSeparated)(opts)
+
+	// --- Then ---
+	assert.Equal(t, IndexSeparated, opts.IndexFormat)
+}
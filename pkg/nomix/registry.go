@@ -5,7 +5,9 @@ package nomix
 
 import (
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 	"sync"
 )
 
@@ -21,14 +23,19 @@ func GlobalRegistry() *Registry { return specs }
 type Registry struct {
 	kinds map[Kind]KindSpec
 	specs map[reflect.Type]KindSpec
+	opts  []Option // Default options.
 	mx    sync.RWMutex
 }
 
-// NewRegistry returns a new [Registry] instance.
-func NewRegistry() *Registry {
+// NewRegistry returns a new [Registry] instance. The options are used as
+// defaults when creating and parsing tags with the registry, for example, to
+// select the slice encoder with [WithSliceEncoder]. Options passed to the
+// methods are applied after the defaults.
+func NewRegistry(opts ...Option) *Registry {
 	return &Registry{
 		kinds: make(map[Kind]KindSpec),
 		specs: make(map[reflect.Type]KindSpec),
+		opts:  opts,
 	}
 }
 
@@ -82,6 +89,13 @@ func (reg *Registry) SpecForKind(knd Kind) KindSpec {
 	return reg.kinds[knd]
 }
 
+// Kinds returns registered kinds in ascending order.
+func (reg *Registry) Kinds() []Kind {
+	reg.mx.RLock()
+	defer reg.mx.RUnlock()
+	return slices.Sorted(maps.Keys(reg.kinds))
+}
+
 // Create creates a new [Tag] for the given value. The value's type must be
 // registered.
 func (reg *Registry) Create(name string, val any, opts ...Option) (Tag, error) {
@@ -89,7 +103,15 @@ func (reg *Registry) Create(name string, val any, opts ...Option) (Tag, error) {
 	defer reg.mx.RUnlock()
 	valTyp := reflect.TypeOf(val)
 	if spec := reg.specs[valTyp]; !spec.IsZero() {
-		return spec.tcr(name, val, opts...)
+		return spec.tcr(name, val, reg.options(opts)...)
 	}
 	return nil, fmt.Errorf("%w for %s of type %T", ErrNoCreator, name, val)
 }
+
+// options returns the registry default options followed by the given ones.
+func (reg *Registry) options(opts []Option) []Option {
+	if len(reg.opts) == 0 {
+		return opts
+	}
+	return append(slices.Clip(reg.opts), opts...)
+}
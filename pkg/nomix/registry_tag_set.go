@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"maps"
+)
+
+// Compile time checks.
+var (
+	_ Tagger         = &RegistryTagSet{}
+	_ AllGetter      = &RegistryTagSet{}
+	_ Metadata       = &RegistryTagSet{}
+	_ MetaAllGetter  = &RegistryTagSet{}
+	_ MetaAllSetter  = &RegistryTagSet{}
+	_ MetaFromGetter = &RegistryTagSet{}
+	_ MetaAppender   = &RegistryTagSet{}
+)
+
+// RegistryTagSet represents a [TagSet] which can be set with untyped
+// metadata values. The values are converted to tags using the [Registry].
+// Since metadata setters don't return errors, conversion errors are
+// collected per key and returned by the [RegistryTagSet.Err] method.
+type RegistryTagSet struct {
+	set  TagSet
+	reg  *Registry
+	errs map[string]error
+}
+
+// NewRegistryTagSet returns a new instance of [RegistryTagSet] wrapping the
+// set. When the registry is nil, the [GlobalRegistry] is used.
+func NewRegistryTagSet(set TagSet, reg *Registry) *RegistryTagSet {
+	if reg == nil {
+		reg = GlobalRegistry()
+	}
+	return &RegistryTagSet{set: set, reg: reg}
+}
+
+// TagGet returns the tag with the given name. Returns nil if the tag doesn't
+// exist.
+func (set *RegistryTagSet) TagGet(name string) Tag {
+	return set.set.TagGet(name)
+}
+
+// TagSet sets the tags in the set. The nil tags are ignored.
+func (set *RegistryTagSet) TagSet(tags ...Tag) {
+	set.set.TagSet(tags...)
+}
+
+// TagDelete deletes the tag with the given name.
+func (set *RegistryTagSet) TagDelete(name string) {
+	set.set.TagDelete(name)
+}
+
+// TagGetAll returns all tags in the set as a map. The returned map should be
+// treated as read-only.
+func (set *RegistryTagSet) TagGetAll() map[string]Tag {
+	return set.set.TagGetAll()
+}
+
+// MetaGet returns the value of the tag with the given name. Returns nil if
+// the tag doesn't exist.
+func (set *RegistryTagSet) MetaGet(key string) any {
+	if tag := set.set.TagGet(key); tag != nil {
+		return tag.TagValue()
+	}
+	return nil
+}
+
+// MetaSet creates a tag from the value and sets it in the set. If the tag
+// with the given name already exists in the set, it will be overwritten. The
+// nil values are ignored. When the tag cannot be created, the existing tag
+// is left unchanged, and the error is recorded for the key.
+func (set *RegistryTagSet) MetaSet(key string, value any) {
+	if value == nil {
+		return
+	}
+	tag, err := set.reg.Create(key, value)
+	if err != nil {
+		if set.errs == nil {
+			set.errs = make(map[string]error)
+		}
+		set.errs[key] = err
+		return
+	}
+	delete(set.errs, key)
+	set.set.TagSet(tag)
+}
+
+// MetaDelete deletes the tag with the given name.
+func (set *RegistryTagSet) MetaDelete(key string) {
+	set.set.TagDelete(key)
+}
+
+// MetaGetAll returns values of all tags in the set as a map. Returns nil when
+// the set is empty.
+func (set *RegistryTagSet) MetaGetAll() map[string]any {
+	return set.set.MetaGetAll()
+}
+
+// MetaAppend appends values of all tags in the set to the map.
+func (set *RegistryTagSet) MetaAppend(m map[string]any) {
+	set.set.MetaAppend(m)
+}
+
+// MetaSetAll sets tags created from all the values in the map the same way
+// as [RegistryTagSet.MetaSet].
+func (set *RegistryTagSet) MetaSetAll(m map[string]any) {
+	for key, val := range m {
+		set.MetaSet(key, val)
+	}
+}
+
+// MetaSetFrom sets tags created from all the values in the source the same
+// way as [RegistryTagSet.MetaSet].
+func (set *RegistryTagSet) MetaSetFrom(src MetaAllGetter) {
+	set.MetaSetAll(src.MetaGetAll())
+}
+
+// Err returns [FieldErrors] with errors of the keys which values couldn't be
+// converted to tags. Returns nil if there were no errors. An error for a key
+// is cleared when a tag for it is set successfully.
+func (set *RegistryTagSet) Err() error {
+	if len(set.errs) == 0 {
+		return nil
+	}
+	return NewFieldErrors(maps.Clone(set.errs))
+}
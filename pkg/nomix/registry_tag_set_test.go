@@ -0,0 +1,321 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_NewRegistryTagSet(t *testing.T) {
+	t.Run("with registry", func(t *testing.T) {
+		// --- Given ---
+		set := NewTagSet()
+		reg := NewRegistry()
+
+		// --- When ---
+		have := NewRegistryTagSet(set, reg)
+
+		// --- Then ---
+		assert.Same(t, reg, have.reg)
+		assert.Nil(t, have.errs)
+		assert.NoError(t, have.Err())
+	})
+
+	t.Run("nil registry", func(t *testing.T) {
+		// --- When ---
+		have := NewRegistryTagSet(NewTagSet(), nil)
+
+		// --- Then ---
+		assert.Same(t, GlobalRegistry(), have.reg)
+	})
+}
+
+func Test_RegistryTagSet_TagGet(t *testing.T) {
+	t.Run("existing", func(t *testing.T) {
+		// --- Given ---
+		tag := tstInt("A", 1)
+		set := NewRegistryTagSet(tstSet(tag), nil)
+
+		// --- When ---
+		have := set.TagGet("A")
+
+		// --- Then ---
+		assert.Same(t, tag, have)
+	})
+
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewRegistryTagSet(NewTagSet(), nil)
+
+		// --- When ---
+		have := set.TagGet("A")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_RegistryTagSet_TagSet(t *testing.T) {
+	// --- Given ---
+	tags := NewTagSet()
+	set := NewRegistryTagSet(tags, nil)
+
+	// --- When ---
+	set.TagSet(tstInt("A", 1), nil, tstStr("B", "b"))
+
+	// --- Then ---
+	assert.Equal(t, map[string]any{"A": 1, "B": "b"}, tags.MetaGetAll())
+}
+
+func Test_RegistryTagSet_TagDelete(t *testing.T) {
+	// --- Given ---
+	tags := tstSet(tstInt("A", 1), tstInt("B", 2))
+	set := NewRegistryTagSet(tags, nil)
+
+	// --- When ---
+	set.TagDelete("A")
+
+	// --- Then ---
+	assert.Equal(t, map[string]any{"B": 2}, tags.MetaGetAll())
+}
+
+func Test_RegistryTagSet_TagGetAll(t *testing.T) {
+	// --- Given ---
+	tag := tstInt("A", 1)
+	set := NewRegistryTagSet(tstSet(tag), nil)
+
+	// --- When ---
+	have := set.TagGetAll()
+
+	// --- Then ---
+	assert.Equal(t, map[string]Tag{"A": tag}, have)
+}
+
+func Test_RegistryTagSet_Tagger(t *testing.T) {
+	// --- Given ---
+	tags := tstSet(tstInt("A", 1))
+	set := NewRegistryTagSet(tags, nil)
+	patch := Diff(tags, tstSet(tstInt("A", 2), tstStr("B", "b")))
+
+	// --- When ---
+	err := patch.Apply(set)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"A": 2, "B": "b"}, tags.MetaGetAll())
+}
+
+func Test_RegistryTagSet_MetaGet(t *testing.T) {
+	t.Run("existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewRegistryTagSet(tstSet(tstInt("A", 1)), nil)
+
+		// --- When ---
+		have := set.MetaGet("A")
+
+		// --- Then ---
+		assert.Equal(t, 1, have)
+	})
+
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewRegistryTagSet(NewTagSet(), nil)
+
+		// --- When ---
+		have := set.MetaGet("A")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_RegistryTagSet_MetaSet(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		tags := tstSet(tstInt("A", 1))
+		set := NewRegistryTagSet(tags, TstRegistry())
+
+		// --- When ---
+		set.MetaSet("A", 2)
+		set.MetaSet("B", "b")
+
+		// --- Then ---
+		assert.NoError(t, set.Err())
+		assert.Equal(t, map[string]any{"A": 2, "B": "b"}, tags.MetaGetAll())
+		assert.Equal(t, KindInt, tags.TagGet("A").TagKind())
+		assert.Equal(t, KindString, tags.TagGet("B").TagKind())
+	})
+
+	t.Run("nil value is ignored", func(t *testing.T) {
+		// --- Given ---
+		tags := tstSet(tstInt("A", 1))
+		set := NewRegistryTagSet(tags, TstRegistry())
+
+		// --- When ---
+		set.MetaSet("A", nil)
+
+		// --- Then ---
+		assert.NoError(t, set.Err())
+		assert.Equal(t, map[string]any{"A": 1}, tags.MetaGetAll())
+	})
+
+	t.Run("error - no creator", func(t *testing.T) {
+		// --- Given ---
+		tags := tstSet(tstInt("A", 1))
+		set := NewRegistryTagSet(tags, TstRegistry())
+
+		// --- When ---
+		set.MetaSet("A", 1.5)
+
+		// --- Then ---
+		fields := tstFieldErrors(t, set.Err())
+		assert.Len(t, 1, fields)
+		assert.ErrorIs(t, ErrNoCreator, fields["A"])
+		assert.ErrorEqual(
+			t,
+			"creator not found for A of type float64",
+			fields["A"],
+		)
+		assert.Equal(t, map[string]any{"A": 1}, tags.MetaGetAll())
+	})
+
+	t.Run("error is cleared on successful set", func(t *testing.T) {
+		// --- Given ---
+		set := NewRegistryTagSet(NewTagSet(), TstRegistry())
+		set.MetaSet("A", 1.5)
+
+		// --- When ---
+		set.MetaSet("A", 1)
+
+		// --- Then ---
+		assert.NoError(t, set.Err())
+		assert.Equal(t, 1, set.MetaGet("A"))
+	})
+}
+
+func Test_RegistryTagSet_MetaDelete(t *testing.T) {
+	// --- Given ---
+	tags := tstSet(tstInt("A", 1), tstInt("B", 2))
+	set := NewRegistryTagSet(tags, nil)
+
+	// --- When ---
+	set.MetaDelete("A")
+
+	// --- Then ---
+	assert.Equal(t, map[string]any{"B": 2}, tags.MetaGetAll())
+}
+
+func Test_RegistryTagSet_MetaSetAll(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		tags := tstSet(tstInt("A", 1))
+		set := NewRegistryTagSet(tags, TstRegistry())
+
+		// --- When ---
+		set.MetaSetAll(map[string]any{"B": 2, "C": "c", "D": nil})
+
+		// --- Then ---
+		assert.NoError(t, set.Err())
+		want := map[string]any{"A": 1, "B": 2, "C": "c"}
+		assert.Equal(t, want, tags.MetaGetAll())
+	})
+
+	t.Run("error - collects errors per key", func(t *testing.T) {
+		// --- Given ---
+		tags := NewTagSet()
+		set := NewRegistryTagSet(tags, TstRegistry())
+
+		// --- When ---
+		set.MetaSetAll(map[string]any{"A": 1, "B": 1.5, "C": true})
+
+		// --- Then ---
+		fields := tstFieldErrors(t, set.Err())
+		assert.Len(t, 2, fields)
+		assert.ErrorIs(t, ErrNoCreator, fields["B"])
+		assert.ErrorIs(t, ErrNoCreator, fields["C"])
+		assert.Equal(t, map[string]any{"A": 1}, tags.MetaGetAll())
+	})
+}
+
+func Test_RegistryTagSet_MetaSetFrom(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		tags := NewTagSet()
+		set := NewRegistryTagSet(tags, TstRegistry())
+		src := MetaSet{m: map[string]any{"A": 1, "B": "b"}}
+
+		// --- When ---
+		set.MetaSetFrom(src)
+
+		// --- Then ---
+		assert.NoError(t, set.Err())
+		assert.Equal(t, map[string]any{"A": 1, "B": "b"}, tags.MetaGetAll())
+	})
+
+	t.Run("error - collects errors per key", func(t *testing.T) {
+		// --- Given ---
+		set := NewRegistryTagSet(NewTagSet(), TstRegistry())
+		src := MetaSet{m: map[string]any{"A": 1, "B": 1.5}}
+
+		// --- When ---
+		set.MetaSetFrom(src)
+
+		// --- Then ---
+		fields := tstFieldErrors(t, set.Err())
+		assert.Len(t, 1, fields)
+		assert.ErrorIs(t, ErrNoCreator, fields["B"])
+	})
+}
+
+func Test_RegistryTagSet_MetaGetAll(t *testing.T) {
+	t.Run("not empty", func(t *testing.T) {
+		// --- Given ---
+		set := NewRegistryTagSet(tstSet(tstInt("A", 1)), nil)
+
+		// --- When ---
+		have := set.MetaGetAll()
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": 1}, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		set := NewRegistryTagSet(NewTagSet(), nil)
+
+		// --- When ---
+		have := set.MetaGetAll()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_RegistryTagSet_MetaAppend(t *testing.T) {
+	// --- Given ---
+	set := NewRegistryTagSet(tstSet(tstInt("A", 1)), nil)
+	m := map[string]any{"B": 2}
+
+	// --- When ---
+	set.MetaAppend(m)
+
+	// --- Then ---
+	assert.Equal(t, map[string]any{"A": 1, "B": 2}, m)
+}
+
+func Test_RegistryTagSet_Err(t *testing.T) {
+	// --- Given ---
+	set := NewRegistryTagSet(NewTagSet(), TstRegistry())
+	set.MetaSet("A", 1.5)
+
+	// --- When ---
+	err := set.Err()
+
+	// --- Then ---
+	fields := tstFieldErrors(t, err)
+	delete(fields, "A")
+	assert.Len(t, 1, tstFieldErrors(t, set.Err()))
+}
@@ -19,14 +19,25 @@ func Test_GlobalRegistry(t *testing.T) {
 }
 
 func Test_NewRegistry(t *testing.T) {
-	// --- When ---
-	reg := NewRegistry()
+	t.Run("no options", func(t *testing.T) {
+		// --- When ---
+		reg := NewRegistry()
 
-	// --- Then ---
-	assert.NotNil(t, reg.kinds)
-	assert.Len(t, 0, reg.kinds)
-	assert.NotNil(t, reg.specs)
-	assert.Len(t, 0, reg.specs)
+		// --- Then ---
+		assert.NotNil(t, reg.kinds)
+		assert.Len(t, 0, reg.kinds)
+		assert.NotNil(t, reg.specs)
+		assert.Len(t, 0, reg.specs)
+		assert.Nil(t, reg.opts)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- When ---
+		reg := NewRegistry(WithRadixHEX)
+
+		// --- Then ---
+		assert.Len(t, 1, reg.opts)
+	})
 }
 
 func Test_Registry_Register(t *testing.T) {
@@ -163,6 +174,33 @@ func Test_Register_SpecForKind(t *testing.T) {
 	})
 }
 
+func Test_Registry_Kinds(t *testing.T) {
+	t.Run("registered", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		must.Nil(reg.Register(NewKindSpec(KindString, nil, nil)))
+		must.Nil(reg.Register(TstIntSpec()))
+		must.Nil(reg.Register(NewKindSpec(KindInt64, nil, nil)))
+
+		// --- When ---
+		have := reg.Kinds()
+
+		// --- Then ---
+		assert.Equal(t, []Kind{KindString, KindInt64, KindInt}, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+
+		// --- When ---
+		have := reg.Kinds()
+
+		// --- Then ---
+		assert.Len(t, 0, have)
+	})
+}
+
 func Test_Registry_Create(t *testing.T) {
 	t.Run("create", func(t *testing.T) {
 		// --- Given ---
@@ -180,6 +218,35 @@ func Test_Registry_Create(t *testing.T) {
 		assert.Equal(t, KindInt, have.TagKind())
 	})
 
+	t.Run("with registry options", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry(WithRadixHEX)
+		must.Nil(reg.Register(TstIntSpec()))
+		must.Value(reg.Associate("", KindInt))
+
+		// --- When ---
+		have, err := reg.Create("name", "AA")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 170, have.TagValue())
+	})
+
+	t.Run("options override registry options", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry(WithRadixHEX)
+		must.Nil(reg.Register(TstIntSpec()))
+		must.Value(reg.Associate("", KindInt))
+		opt := func(opts *Options) { opts.Radix = 10 }
+
+		// --- When ---
+		have, err := reg.Create("name", "AA", opt)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+
 	t.Run("error - not registered type", func(t *testing.T) {
 		// --- Given ---
 		reg := NewRegistry()
@@ -194,3 +261,33 @@ func Test_Registry_Create(t *testing.T) {
 		assert.Nil(t, have)
 	})
 }
+
+func Test_Registry_options(t *testing.T) {
+	t.Run("no registry options", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		opts := []Option{WithRadixHEX}
+
+		// --- When ---
+		have := reg.options(opts)
+
+		// --- Then ---
+		assert.Same(t, opts, have)
+	})
+
+	t.Run("with registry options", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry(WithVerbose, WithNoTrim)
+
+		// --- When ---
+		have := reg.options([]Option{WithRadixHEX})
+
+		// --- Then ---
+		assert.Len(t, 3, have)
+		assert.Len(t, 2, reg.opts)
+		def := NewOptions(have...)
+		assert.True(t, def.Verbose)
+		assert.False(t, def.Trim)
+		assert.Equal(t, 16, def.Radix)
+	})
+}
@@ -0,0 +1,373 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+
+	"github.com/ctx42/verax/pkg/spec"
+	"github.com/ctx42/xrr/pkg/xrr"
+)
+
+// SchemaSpecName identifies [Schema] in a [spec.Spec].
+const SchemaSpecName = "schema"
+
+// Names of [Schema] spec arguments.
+const (
+	argFields    = "fields"    // Tag definition specs.
+	argRequired  = "required"  // Tag is required.
+	argDefault   = "default"   // Tag default value.
+	argForbidden = "forbidden" // Forbidden tag names.
+	argUnknown   = "unknown"   // Unknown tags policy.
+)
+
+// UnknownPolicy represents the way [Schema] handles tags without definitions.
+type UnknownPolicy uint8
+
+// Unknown tag policies.
+const (
+	// UnknownAllow allows tags without definitions.
+	UnknownAllow UnknownPolicy = iota
+
+	// UnknownReject reports tags without definitions as errors.
+	UnknownReject
+
+	// UnknownRemove removes tags without definitions when the schema is
+	// applied to the set, validation allows them.
+	UnknownRemove
+)
+
+// schemaField represents a tag defined in a [Schema].
+type schemaField struct {
+	def      *Definition // Tag definition.
+	required bool        // Tag is required.
+	deflt    Tag         // Default tag, nil if none.
+}
+
+// Schema represents a named collection of tag definitions describing a whole
+// tag set. Tags can be required, optional, optional with default values or
+// forbidden.
+type Schema struct {
+	name      string                  // Schema name.
+	fields    map[string]*schemaField // Defined tags by name.
+	forbidden map[string]struct{}     // Forbidden tag names.
+	unknown   UnknownPolicy           // Unknown tags policy.
+}
+
+// NewSchema returns a new instance of [Schema] with the [UnknownAllow]
+// policy.
+func NewSchema(name string) *Schema {
+	return &Schema{
+		name:      name,
+		fields:    make(map[string]*schemaField),
+		forbidden: make(map[string]struct{}),
+	}
+}
+
+// SchemaName returns the schema name.
+func (sch *Schema) SchemaName() string { return sch.name }
+
+// Require adds definitions of required tags. Definitions of the same tag
+// names are replaced.
+func (sch *Schema) Require(defs ...*Definition) {
+	for _, def := range defs {
+		sch.add(&schemaField{def: def, required: true})
+	}
+}
+
+// Allow adds definitions of optional tags. Definitions of the same tag
+// names are replaced.
+func (sch *Schema) Allow(defs ...*Definition) {
+	for _, def := range defs {
+		sch.add(&schemaField{def: def})
+	}
+}
+
+// Default adds the definition of an optional tag with the default value. The
+// definition of the same tag name is replaced. Returns an error if the tag
+// with the default value cannot be created or is not valid.
+func (sch *Schema) Default(def *Definition, val any, opts ...Option) error {
+	tag, err := def.TagCreate(val, opts...)
+	if err != nil {
+		return err
+	}
+	sch.add(&schemaField{def: def, deflt: tag})
+	return nil
+}
+
+// Forbid marks tag names as forbidden. Definitions of the same tag names are
+// removed.
+func (sch *Schema) Forbid(names ...string) {
+	for _, name := range names {
+		delete(sch.fields, name)
+		sch.forbidden[name] = struct{}{}
+	}
+}
+
+// SetUnknown sets the policy for tags without definitions.
+func (sch *Schema) SetUnknown(policy UnknownPolicy) { sch.unknown = policy }
+
+// Names returns sorted names of defined tags.
+func (sch *Schema) Names() []string {
+	return slices.Sorted(maps.Keys(sch.fields))
+}
+
+// Definition returns the named tag definition or nil if it doesn't exist.
+func (sch *Schema) Definition(name string) *Definition {
+	if fld, ok := sch.fields[name]; ok {
+		return fld.def
+	}
+	return nil
+}
+
+// add adds the field to the schema.
+func (sch *Schema) add(fld *schemaField) {
+	name := fld.def.TagName()
+	delete(sch.forbidden, name)
+	sch.fields[name] = fld
+}
+
+// Validate validates the tags against the schema. It checks that required
+// tags exist, forbidden and, with the [UnknownReject] policy, unknown tags
+// don't, and that defined tags have the kind of their definitions and valid
+// values.
+//
+// Returns nil when tags are valid, or [FieldErrors] with problems found by
+// tag names. The errors wrap [ErrMissing], [ErrForbidden], [ErrUnknown] and
+// [ErrInvType], or are returned by the definition validation rules.
+func (sch *Schema) Validate(set AllGetter) error {
+	return sch.validate(func(yield func(string, any) bool) {
+		for name, tag := range set.TagGetAll() {
+			if tag != nil && !yield(name, tag) {
+				return
+			}
+		}
+	})
+}
+
+// ValidateMeta validates the metadata against the schema the same way as the
+// [Schema.Validate] does with tags. The values are validated by creating
+// tags using their definitions.
+func (sch *Schema) ValidateMeta(set MetaAllGetter) error {
+	return sch.validate(func(yield func(string, any) bool) {
+		for name, val := range set.MetaGetAll() {
+			if val != nil && !yield(name, val) {
+				return
+			}
+		}
+	})
+}
+
+// Apply sets tags with default values missing in the set and, with the
+// [UnknownRemove] policy, removes tags without definitions. Then it validates
+// tags the same way as [Schema.Validate].
+func (sch *Schema) Apply(set TagManager) error {
+	if sch.unknown == UnknownRemove {
+		for _, name := range slices.Collect(maps.Keys(set.TagGetAll())) {
+			if sch.isUnknown(name) {
+				set.TagDelete(name)
+			}
+		}
+	}
+	for _, fld := range sch.fields {
+		if fld.deflt != nil && set.TagGet(fld.def.TagName()) == nil {
+			set.TagSet(cloneTag(fld.deflt))
+		}
+	}
+	return sch.Validate(set)
+}
+
+// ApplyMeta sets the metadata the same way as [Schema.Apply] does with tags.
+func (sch *Schema) ApplyMeta(set MetaManager) error {
+	if sch.unknown == UnknownRemove {
+		for _, name := range slices.Collect(maps.Keys(set.MetaGetAll())) {
+			if sch.isUnknown(name) {
+				set.MetaDelete(name)
+			}
+		}
+	}
+	for _, fld := range sch.fields {
+		if fld.deflt != nil && set.MetaGet(fld.def.TagName()) == nil {
+			set.MetaSet(fld.def.TagName(), cloneTag(fld.deflt).TagValue())
+		}
+	}
+	return sch.ValidateMeta(set)
+}
+
+// validate validates tags or metadata values.
+func (sch *Schema) validate(all iter.Seq2[string, any]) error {
+	errs := make(map[string]error)
+	present := make(map[string]struct{})
+	for name, val := range all {
+		present[name] = struct{}{}
+		if err := sch.check(name, val); err != nil {
+			errs[name] = err
+		}
+	}
+	for name, fld := range sch.fields {
+		if _, ok := present[name]; !ok && fld.required {
+			errs[name] = ErrMissing
+		}
+	}
+	if len(errs) > 0 {
+		return NewFieldErrors(errs)
+	}
+	return nil
+}
+
+// check checks the tag, or metadata value, against the schema.
+func (sch *Schema) check(name string, val any) error {
+	if _, ok := sch.forbidden[name]; ok {
+		return ErrForbidden
+	}
+	fld, ok := sch.fields[name]
+	if !ok {
+		if sch.unknown == UnknownReject {
+			return ErrUnknown
+		}
+		return nil
+	}
+	tag, ok := val.(Tag)
+	if !ok {
+		var err error
+		if tag, err = fld.def.spec.TagCreate(name, val); err != nil {
+			return err
+		}
+	}
+	if knd := tag.TagKind(); knd != fld.def.TagKind() {
+		const format = "%w: %s instead of %s"
+		return fmt.Errorf(format, ErrInvType, knd, fld.def.TagKind())
+	}
+	if fld.def.rule == nil {
+		return nil
+	}
+	return fld.def.rule.Validate(tag.TagValue())
+}
+
+// isUnknown returns true if the tag name has no definition and is not
+// forbidden.
+func (sch *Schema) isUnknown(name string) bool {
+	_, defined := sch.fields[name]
+	_, forbidden := sch.forbidden[name]
+	return !defined && !forbidden
+}
+
+// Spec returns the [spec.Spec] representing the schema. Tags are encoded
+// using the [Definition.Spec] method with additional arguments for required
+// tags and the string representations of default values. Returns an error if
+// any of the definitions cannot be encoded, or the default tag doesn't
+// implement [fmt.Stringer].
+func (sch *Schema) Spec() (*spec.Spec, error) {
+	fSpcs := make([]*spec.Spec, 0, len(sch.fields))
+	for _, name := range sch.Names() {
+		fld := sch.fields[name]
+		fSpc, err := fld.def.Spec()
+		if err != nil {
+			return nil, err
+		}
+		if fld.required {
+			fSpc.SetArg(argRequired, true)
+		}
+		if fld.deflt != nil {
+			str, ok := fld.deflt.(fmt.Stringer)
+			if !ok {
+				return nil, NewInternalErrorf(
+					"%s: %s: default value %w for %T",
+					SchemaSpecName,
+					name,
+					ErrNotImpl,
+					fld.deflt,
+				)
+			}
+			fSpc.SetArg(argDefault, str.String())
+		}
+		fSpcs = append(fSpcs, fSpc)
+	}
+	spc := spec.NewSpec(SchemaSpecName).
+		SetArg(argName, sch.name).
+		SetArg(argFields, fSpcs).
+		SetArg(argForbidden, slices.Sorted(maps.Keys(sch.forbidden))).
+		SetArg(argUnknown, uint8(sch.unknown))
+	return spc, nil
+}
+
+// SchemaFromSpec creates a [Schema] from a [spec.Spec] created by the
+// [Schema.Spec] method. Tag definitions are created using the
+// [DefinitionFromSpec] function and default values are parsed using
+// [Definition.TagParse] with the given options.
+func SchemaFromSpec(
+	reg *Registry,
+	spc *spec.Spec,
+	dec RuleDecoder,
+	opts ...Option,
+) (*Schema, error) {
+
+	if spc.Name != SchemaSpecName {
+		return nil, NewInternalErrorf(
+			"%s: invalid spec name: %q",
+			SchemaSpecName,
+			spc.Name,
+			xrr.WithCode(spec.ECInvSpec),
+		)
+	}
+	name, err := getSpecArg[string](spc.Args, argName, SchemaSpecName)
+	if err != nil {
+		return nil, err
+	}
+	fSpcs, err := getSpecArg[[]*spec.Spec](spc.Args, argFields, SchemaSpecName)
+	if err != nil {
+		return nil, err
+	}
+	forbidden, err := getSpecArg[[]string](
+		spc.Args,
+		argForbidden,
+		SchemaSpecName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	unknown, err := getSpecArg[uint8](spc.Args, argUnknown, SchemaSpecName)
+	if err != nil {
+		return nil, err
+	}
+
+	sch := NewSchema(name)
+	for _, fSpc := range fSpcs {
+		def, err := DefinitionFromSpec(reg, fSpc, dec)
+		if err != nil {
+			return nil, err
+		}
+		fld := &schemaField{def: def}
+		if _, ok := fSpc.Args[argRequired]; ok {
+			fld.required, err = getSpecArg[bool](
+				fSpc.Args,
+				argRequired,
+				SchemaSpecName,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if _, ok := fSpc.Args[argDefault]; ok {
+			val, err := getSpecArg[string](
+				fSpc.Args,
+				argDefault,
+				SchemaSpecName,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if fld.deflt, err = def.TagParse(val, opts...); err != nil {
+				return nil, err
+			}
+		}
+		sch.add(fld)
+	}
+	sch.Forbid(forbidden...)
+	sch.SetUnknown(UnknownPolicy(unknown))
+	return sch, nil
+}
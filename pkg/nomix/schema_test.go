@@ -0,0 +1,700 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/verax/pkg/spec"
+	"github.com/ctx42/verax/pkg/verax"
+)
+
+// tstStrSpec returns the [KindString] spec used in schema tests.
+func tstStrSpec() KindSpec {
+	create := func(name string, val any, _ ...Option) (Tag, error) {
+		if v, ok := val.(string); ok {
+			return tstStr(name, v), nil
+		}
+		return nil, ErrInvType
+	}
+	return NewKindSpec(KindString, create, tstStrParse)
+}
+
+// tstSchema returns the schema used in tests.
+func tstSchema(t *testing.T) *Schema {
+	t.Helper()
+	sch := NewSchema("asset")
+	sch.Require(
+		Define("id", TstIntSpec()),
+		Define("env", tstStrSpec(), &TstRule{}),
+	)
+	sch.Allow(Define("owner", tstStrSpec()))
+	err := sch.Default(Define("replicas", TstIntSpec()), 1)
+	assert.NoError(t, err)
+	sch.Forbid("secret")
+	return sch
+}
+
+// tstFieldErrors returns errors by field names from the [FieldErrors].
+func tstFieldErrors(t *testing.T, err error) map[string]error {
+	t.Helper()
+	var fe *FieldErrors
+	assert.True(t, errors.As(err, &fe))
+	return fe.ErrorFields()
+}
+
+func Test_NewSchema(t *testing.T) {
+	// --- When ---
+	have := NewSchema("asset")
+
+	// --- Then ---
+	assert.Equal(t, "asset", have.name)
+	assert.Len(t, 0, have.fields)
+	assert.Len(t, 0, have.forbidden)
+	assert.Equal(t, UnknownAllow, have.unknown)
+}
+
+func Test_Schema_SchemaName(t *testing.T) {
+	// --- Given ---
+	sch := NewSchema("asset")
+
+	// --- When ---
+	have := sch.SchemaName()
+
+	// --- Then ---
+	assert.Equal(t, "asset", have)
+}
+
+func Test_Schema_Require(t *testing.T) {
+	// --- Given ---
+	sch := NewSchema("asset")
+	sch.Forbid("A")
+	def := Define("A", TstIntSpec())
+
+	// --- When ---
+	sch.Require(def)
+
+	// --- Then ---
+	assert.Same(t, def, sch.fields["A"].def)
+	assert.True(t, sch.fields["A"].required)
+	assert.Nil(t, sch.fields["A"].deflt)
+	assert.Len(t, 0, sch.forbidden)
+}
+
+func Test_Schema_Allow(t *testing.T) {
+	// --- Given ---
+	sch := NewSchema("asset")
+	sch.Require(Define("A", TstIntSpec()))
+	def := Define("A", TstIntSpec())
+
+	// --- When ---
+	sch.Allow(def)
+
+	// --- Then ---
+	assert.Same(t, def, sch.fields["A"].def)
+	assert.False(t, sch.fields["A"].required)
+}
+
+func Test_Schema_Default(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		// --- Given ---
+		sch := NewSchema("asset")
+		def := Define("A", TstIntSpec())
+
+		// --- When ---
+		err := sch.Default(def, 42)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Same(t, def, sch.fields["A"].def)
+		assert.False(t, sch.fields["A"].required)
+		assert.Equal(t, 42, sch.fields["A"].deflt.TagValue())
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- Given ---
+		sch := NewSchema("asset")
+		def := Define("A", TstIntSpec())
+
+		// --- When ---
+		err := sch.Default(def, "abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Len(t, 0, sch.fields)
+	})
+
+	t.Run("error - invalid value", func(t *testing.T) {
+		// --- Given ---
+		sch := NewSchema("asset")
+		e := errors.New("test error")
+		def := Define("A", TstIntSpec(), &TstRule{Err: e})
+
+		// --- When ---
+		err := sch.Default(def, 42)
+
+		// --- Then ---
+		assert.ErrorContain(t, "test error", err)
+		assert.Len(t, 0, sch.fields)
+	})
+}
+
+func Test_Schema_Forbid(t *testing.T) {
+	// --- Given ---
+	sch := NewSchema("asset")
+	sch.Allow(Define("A", TstIntSpec()))
+
+	// --- When ---
+	sch.Forbid("A", "B")
+
+	// --- Then ---
+	assert.Len(t, 0, sch.fields)
+	assert.HasKey(t, "A", sch.forbidden)
+	assert.HasKey(t, "B", sch.forbidden)
+}
+
+func Test_Schema_SetUnknown(t *testing.T) {
+	// --- Given ---
+	sch := NewSchema("asset")
+
+	// --- When ---
+	sch.SetUnknown(UnknownReject)
+
+	// --- Then ---
+	assert.Equal(t, UnknownReject, sch.unknown)
+}
+
+func Test_Schema_Names(t *testing.T) {
+	// --- Given ---
+	sch := tstSchema(t)
+
+	// --- When ---
+	have := sch.Names()
+
+	// --- Then ---
+	assert.Equal(t, []string{"env", "id", "owner", "replicas"}, have)
+}
+
+func Test_Schema_Definition(t *testing.T) {
+	t.Run("existing", func(t *testing.T) {
+		// --- Given ---
+		def := Define("A", TstIntSpec())
+		sch := NewSchema("asset")
+		sch.Allow(def)
+
+		// --- When ---
+		have := sch.Definition("A")
+
+		// --- Then ---
+		assert.Same(t, def, have)
+	})
+
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		sch := NewSchema("asset")
+
+		// --- When ---
+		have := sch.Definition("A")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Schema_Validate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- Given ---
+		sch := tstSchema(t)
+		set := tstSet(tstInt("id", 1), tstStr("env", "prod"), tstInt("x", 1))
+
+		// --- When ---
+		err := sch.Validate(set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("all problems", func(t *testing.T) {
+		// --- Given ---
+		e := errors.New("test error")
+		sch := tstSchema(t)
+		sch.Allow(Define("owner", tstStrSpec(), &TstRule{Err: e}))
+		sch.SetUnknown(UnknownReject)
+		set := tstSet(
+			tstStr("id", "1"),
+			tstStr("owner", "bob"),
+			tstStr("secret", "abc"),
+			tstInt("x", 1),
+		)
+
+		// --- When ---
+		err := sch.Validate(set)
+
+		// --- Then ---
+		assert.True(t, IsValidationError(err))
+		have := tstFieldErrors(t, err)
+		assert.Len(t, 5, have)
+		assert.ErrorIs(t, ErrInvType, have["id"])
+		assert.ErrorEqual(
+			t,
+			"invalid element type: KindString instead of KindInt",
+			have["id"],
+		)
+		assert.ErrorIs(t, ErrMissing, have["env"])
+		assert.ErrorIs(t, e, have["owner"])
+		assert.ErrorIs(t, ErrForbidden, have["secret"])
+		assert.ErrorIs(t, ErrUnknown, have["x"])
+	})
+
+	t.Run("does not apply defaults", func(t *testing.T) {
+		// --- Given ---
+		sch := tstSchema(t)
+		set := tstSet(tstInt("id", 1), tstStr("env", "prod"))
+
+		// --- When ---
+		err := sch.Validate(set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 2, set.TagCount())
+	})
+
+	t.Run("unknown removed policy allows unknown tags", func(t *testing.T) {
+		// --- Given ---
+		sch := tstSchema(t)
+		sch.SetUnknown(UnknownRemove)
+		set := tstSet(tstInt("id", 1), tstStr("env", "prod"), tstInt("x", 1))
+
+		// --- When ---
+		err := sch.Validate(set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 3, set.TagCount())
+	})
+}
+
+func Test_Schema_ValidateMeta(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- Given ---
+		sch := tstSchema(t)
+		set := NewMetaSet(WithMeta(map[string]any{"id": 1, "env": "prod"}))
+
+		// --- When ---
+		err := sch.ValidateMeta(set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("all problems", func(t *testing.T) {
+		// --- Given ---
+		sch := tstSchema(t)
+		sch.SetUnknown(UnknownReject)
+		m := map[string]any{"id": 1.5, "secret": "abc", "x": 1}
+		set := NewMetaSet(WithMeta(m))
+
+		// --- When ---
+		err := sch.ValidateMeta(set)
+
+		// --- Then ---
+		have := tstFieldErrors(t, err)
+		assert.Len(t, 4, have)
+		assert.ErrorIs(t, ErrInvType, have["id"])
+		assert.ErrorIs(t, ErrMissing, have["env"])
+		assert.ErrorIs(t, ErrForbidden, have["secret"])
+		assert.ErrorIs(t, ErrUnknown, have["x"])
+	})
+}
+
+func Test_Schema_Apply(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		// --- Given ---
+		sch := tstSchema(t)
+		set := tstSet(tstInt("id", 1), tstStr("env", "prod"))
+
+		// --- When ---
+		err := sch.Apply(set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 1, set.TagGet("replicas").TagValue())
+		assert.NotSame(t, sch.fields["replicas"].deflt, set.TagGet("replicas"))
+	})
+
+	t.Run("existing not replaced with default", func(t *testing.T) {
+		// --- Given ---
+		sch := tstSchema(t)
+		set := tstSet(
+			tstInt("id", 1),
+			tstStr("env", "prod"),
+			tstInt("replicas", 3),
+		)
+
+		// --- When ---
+		err := sch.Apply(set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 3, set.TagGet("replicas").TagValue())
+	})
+
+	t.Run("remove unknown", func(t *testing.T) {
+		// --- Given ---
+		sch := tstSchema(t)
+		sch.SetUnknown(UnknownRemove)
+		set := tstSet(
+			tstInt("id", 1),
+			tstStr("env", "prod"),
+			tstInt("x", 1),
+			tstInt("y", 1),
+		)
+
+		// --- When ---
+		err := sch.Apply(set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, set.TagGet("x"))
+		assert.Nil(t, set.TagGet("y"))
+		assert.Equal(t, 3, set.TagCount())
+	})
+
+	t.Run("forbidden not removed", func(t *testing.T) {
+		// --- Given ---
+		sch := tstSchema(t)
+		sch.SetUnknown(UnknownRemove)
+		set := tstSet(
+			tstInt("id", 1),
+			tstStr("env", "prod"),
+			tstStr("secret", "abc"),
+		)
+
+		// --- When ---
+		err := sch.Apply(set)
+
+		// --- Then ---
+		have := tstFieldErrors(t, err)
+		assert.Len(t, 1, have)
+		assert.ErrorIs(t, ErrForbidden, have["secret"])
+		assert.NotNil(t, set.TagGet("secret"))
+	})
+
+	t.Run("error - missing", func(t *testing.T) {
+		// --- Given ---
+		sch := tstSchema(t)
+		set := tstSet(tstStr("env", "prod"))
+
+		// --- When ---
+		err := sch.Apply(set)
+
+		// --- Then ---
+		have := tstFieldErrors(t, err)
+		assert.Len(t, 1, have)
+		assert.ErrorIs(t, ErrMissing, have["id"])
+		assert.NotNil(t, set.TagGet("replicas"))
+	})
+}
+
+func Test_Schema_ApplyMeta(t *testing.T) {
+	t.Run("defaults and remove unknown", func(t *testing.T) {
+		// --- Given ---
+		sch := tstSchema(t)
+		sch.SetUnknown(UnknownRemove)
+		m := map[string]any{"id": 1, "env": "prod", "x": 1}
+		set := NewMetaSet(WithMeta(m))
+
+		// --- When ---
+		err := sch.ApplyMeta(set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := map[string]any{"id": 1, "env": "prod", "replicas": 1}
+		assert.Equal(t, exp, set.MetaGetAll())
+	})
+
+	t.Run("existing not replaced with default", func(t *testing.T) {
+		// --- Given ---
+		sch := tstSchema(t)
+		m := map[string]any{"id": 1, "env": "prod", "replicas": 3}
+		set := NewMetaSet(WithMeta(m))
+
+		// --- When ---
+		err := sch.ApplyMeta(set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 3, set.MetaGet("replicas"))
+	})
+
+	t.Run("error - missing", func(t *testing.T) {
+		// --- Given ---
+		sch := tstSchema(t)
+		set := NewMetaSet(WithMeta(map[string]any{"env": "prod"}))
+
+		// --- When ---
+		err := sch.ApplyMeta(set)
+
+		// --- Then ---
+		have := tstFieldErrors(t, err)
+		assert.Len(t, 1, have)
+		assert.ErrorIs(t, ErrMissing, have["id"])
+	})
+}
+
+func Test_Schema_Spec(t *testing.T) {
+	t.Run("spec", func(t *testing.T) {
+		// --- Given ---
+		sch := NewSchema("asset")
+		sch.Require(Define("id", TstIntSpec(), verax.Min(1)))
+		sch.Allow(Define("env", tstStrSpec()))
+		must.Nil(sch.Default(Define("replicas", TstIntSpec()), 3))
+		sch.Forbid("b", "a")
+		sch.SetUnknown(UnknownReject)
+
+		// --- When ---
+		have, err := sch.Spec()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, SchemaSpecName, have.Name)
+		assert.Equal(t, "asset", have.Args[argName])
+		assert.Equal(t, []string{"a", "b"}, have.Args[argForbidden])
+		assert.Equal(t, uint8(UnknownReject), have.Args[argUnknown])
+
+		fSpcs := have.Args[argFields].([]*spec.Spec)
+		assert.Len(t, 3, fSpcs)
+		assert.Equal(t, "env", fSpcs[0].Args[argName])
+		assert.HasNoKey(t, argRequired, fSpcs[0].Args)
+		assert.HasNoKey(t, argDefault, fSpcs[0].Args)
+		assert.Equal(t, "id", fSpcs[1].Args[argName])
+		assert.Equal(t, true, fSpcs[1].Args[argRequired])
+		assert.HasKey(t, argRules, fSpcs[1].Args)
+		assert.Equal(t, "replicas", fSpcs[2].Args[argName])
+		assert.Equal(t, "3", fSpcs[2].Args[argDefault])
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		sch := NewSchema("asset")
+
+		// --- When ---
+		have, err := sch.Spec()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 0, have.Args[argFields])
+		assert.Len(t, 0, have.Args[argForbidden])
+		assert.Equal(t, uint8(UnknownAllow), have.Args[argUnknown])
+	})
+
+	t.Run("error - definition", func(t *testing.T) {
+		// --- Given ---
+		sch := NewSchema("asset")
+		sch.Allow(Define("id", TstIntSpec(), &TstRule{}))
+
+		// --- When ---
+		have, err := sch.Spec()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotImpl, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - default not stringer", func(t *testing.T) {
+		// --- Given ---
+		create := func(name string, val any, _ ...Option) (Tag, error) {
+			return tstPlainTag{name: name}, nil
+		}
+		ks := NewKindSpec(KindIntSlice, create, nil)
+		sch := NewSchema("asset")
+		must.Nil(sch.Default(Define("id", ks), []int{1}))
+
+		// --- When ---
+		have, err := sch.Spec()
+
+		// --- Then ---
+		assert.SameType(t, &InternalError{}, err)
+		assert.ErrorIs(t, ErrNotImpl, err)
+		wMsg := "schema: id: default value not implemented for " +
+			"nomix.tstPlainTag"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_SchemaFromSpec(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		must.Nil(reg.Register(TstIntSpec()))
+		must.Nil(reg.Register(tstStrSpec()))
+
+		sch := NewSchema("asset")
+		sch.Require(Define("id", TstIntSpec(), verax.Min(1)))
+		sch.Allow(Define("env", tstStrSpec()))
+		must.Nil(sch.Default(Define("replicas", TstIntSpec()), 3))
+		sch.Forbid("secret")
+		sch.SetUnknown(UnknownReject)
+		spc := must.Value(sch.Spec())
+
+		// --- When ---
+		have, err := SchemaFromSpec(reg, spc, tstRuleDecoder)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "asset", have.SchemaName())
+		assert.Equal(t, []string{"env", "id", "replicas"}, have.Names())
+		assert.True(t, have.fields["id"].required)
+		assert.False(t, have.fields["env"].required)
+		assert.Equal(t, 3, have.fields["replicas"].deflt.TagValue())
+		assert.HasKey(t, "secret", have.forbidden)
+		assert.Equal(t, UnknownReject, have.unknown)
+
+		set := tstSet(tstInt("id", 0), tstStr("secret", "abc"))
+		errs := tstFieldErrors(t, have.Apply(set))
+		assert.Len(t, 2, errs)
+		assert.Error(t, errs["id"])
+		assert.ErrorIs(t, ErrForbidden, errs["secret"])
+		assert.Equal(t, 3, set.TagGet("replicas").TagValue())
+	})
+
+	t.Run("error - invalid spec name", func(t *testing.T) {
+		// --- Given ---
+		spc := spec.NewSpec("other")
+
+		// --- When ---
+		have, err := SchemaFromSpec(NewRegistry(), spc, nil)
+
+		// --- Then ---
+		assert.SameType(t, &InternalError{}, err)
+		assert.ErrorEqual(t, `schema: invalid spec name: "other"`, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid definition", func(t *testing.T) {
+		// --- Given ---
+		sch := NewSchema("asset")
+		sch.Allow(Define("id", TstIntSpec()))
+		spc := must.Value(sch.Spec())
+
+		// --- When ---
+		have, err := SchemaFromSpec(NewRegistry(), spc, nil)
+
+		// --- Then ---
+		assert.ErrorEqual(t, `kind-spec: invalid spec kind: 516`, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid default", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		must.Nil(reg.Register(TstIntSpec()))
+		sch := NewSchema("asset")
+		must.Nil(sch.Default(Define("id", TstIntSpec()), 3))
+		spc := must.Value(sch.Spec())
+		spc.Args[argFields].([]*spec.Spec)[0].SetArg(argDefault, "abc")
+
+		// --- When ---
+		have, err := SchemaFromSpec(reg, spc, nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_SchemaFromSpec_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		key string
+		val any
+		exp string
+	}{
+		{
+			"name",
+			argName,
+			1,
+			`schema: spec argument "name" must be string, got int`,
+		},
+		{
+			"fields",
+			argFields,
+			1,
+			`schema: spec argument "fields" must be []*spec.Spec, got int`,
+		},
+		{
+			"forbidden",
+			argForbidden,
+			1,
+			`schema: spec argument "forbidden" must be []string, got int`,
+		},
+		{
+			"unknown",
+			argUnknown,
+			1,
+			`schema: spec argument "unknown" must be uint8, got int`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			spc := must.Value(NewSchema("asset").Spec())
+			spc.SetArg(tc.key, tc.val)
+
+			// --- When ---
+			have, err := SchemaFromSpec(NewRegistry(), spc, nil)
+
+			// --- Then ---
+			assert.SameType(t, &InternalError{}, err)
+			assert.ErrorEqual(t, tc.exp, err)
+			assert.Nil(t, have)
+		})
+	}
+}
+
+func Test_SchemaFromSpec_field_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		key string
+		exp string
+	}{
+		{
+			"required",
+			argRequired,
+			`schema: spec argument "required" must be bool, got int`,
+		},
+		{
+			"default",
+			argDefault,
+			`schema: spec argument "default" must be string, got int`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			reg := NewRegistry()
+			must.Nil(reg.Register(TstIntSpec()))
+			sch := NewSchema("asset")
+			sch.Allow(Define("id", TstIntSpec()))
+			spc := must.Value(sch.Spec())
+			spc.Args[argFields].([]*spec.Spec)[0].SetArg(tc.key, 1)
+
+			// --- When ---
+			have, err := SchemaFromSpec(reg, spc, nil)
+
+			// --- Then ---
+			assert.SameType(t, &InternalError{}, err)
+			assert.ErrorEqual(t, tc.exp, err)
+			assert.Nil(t, have)
+		})
+	}
+}
@@ -4,7 +4,9 @@
 package nomix
 
 import (
+	"database/sql"
 	"database/sql/driver"
+	"fmt"
 
 	"github.com/ctx42/verax/pkg/verax"
 )
@@ -14,15 +16,18 @@ var (
 	_ Tag           = &Single[int]{}
 	_ ValueComparer = &Single[int]{}
 	_ Comparer      = &Single[int]{}
+	_ Cloner        = &Single[int]{}
+	_ sql.Scanner   = &Single[int]{}
 )
 
 // Single is a generic type for single value [Tag].
 type Single[T comparable] struct {
-	name      string                        // Tag name.
-	value     T                             // Tag value.
-	kind      Kind                          // Tag kind.
-	strValuer func(T) string                // T to string function.
-	sqlValuer func(T) (driver.Value, error) // T to SQL value function.
+	name       string                        // Tag name.
+	value      T                             // Tag value.
+	kind       Kind                          // Tag kind.
+	strValuer  func(T) string                // T to string function.
+	sqlValuer  func(T) (driver.Value, error) // T to SQL value function.
+	sqlScanner func(any) (T, error)          // SQL value to T function.
 }
 
 // NewSingle returns a new instance of [Single].
@@ -32,14 +37,16 @@ func NewSingle[T comparable](
 	kind Kind,
 	strValuer func(T) string,
 	sqlValuer func(T) (driver.Value, error),
+	sqlScanner func(any) (T, error),
 ) *Single[T] {
 
 	return &Single[T]{
-		name:      name,
-		value:     val,
-		kind:      kind,
-		strValuer: strValuer,
-		sqlValuer: sqlValuer,
+		name:       name,
+		value:      val,
+		kind:       kind,
+		strValuer:  strValuer,
+		sqlValuer:  sqlValuer,
+		sqlScanner: sqlScanner,
 	}
 }
 
@@ -66,6 +73,32 @@ func (tag *Single[T]) Value() (driver.Value, error) {
 	return tag.sqlValuer(tag.value)
 }
 
+// Scan implements [sql.Scanner] interface. The NULL database value sets the
+// zero value of the tag's value type, the same way [Slice.Scan] sets the nil
+// slice. When the type has no SQL scanner defined, then the value must be of
+// the tag's value type. Returns an error prefixed with the tag name if the
+// value cannot be converted.
+func (tag *Single[T]) Scan(src any) error {
+	if src == nil {
+		var zero T
+		tag.value = zero
+		return nil
+	}
+	if tag.sqlScanner == nil {
+		if v, ok := src.(T); ok {
+			tag.value = v
+			return nil
+		}
+		return fmt.Errorf("%s: %w", tag.name, ErrInvType)
+	}
+	v, err := tag.sqlScanner(src)
+	if err != nil {
+		return fmt.Errorf("%s: %w", tag.name, err)
+	}
+	tag.value = v
+	return nil
+}
+
 func (tag *Single[T]) TagEqual(other Tag) bool {
 	if other == nil {
 		return false
@@ -86,6 +119,11 @@ func (tag *Single[T]) TagSame(other Tag) bool {
 	return false
 }
 
+func (tag *Single[T]) TagClone() Tag {
+	cpy := *tag
+	return &cpy
+}
+
 func (tag *Single[T]) String() string { return tag.strValuer(tag.value) }
 
 func (tag *Single[T]) ValidateWith(rule verax.Rule) error {
@@ -16,9 +16,17 @@ import (
 func Test_NewSingle(t *testing.T) {
 	// --- Given ---
 	sqlValuer := func(v int) (driver.Value, error) { return v, nil }
+	sqlScanner := func(v any) (int, error) { return 0, nil }
 
 	// --- When ---
-	have := NewSingle[int]("name", 42, KindInt, strconv.Itoa, sqlValuer)
+	have := NewSingle(
+		"name",
+		42,
+		KindInt,
+		strconv.Itoa,
+		sqlValuer,
+		sqlScanner,
+	)
 
 	// --- Then ---
 	assert.Equal(t, "name", have.name)
@@ -26,6 +34,7 @@ func Test_NewSingle(t *testing.T) {
 	assert.Equal(t, KindInt, have.kind)
 	assert.Same(t, strconv.Itoa, have.strValuer)
 	assert.Same(t, sqlValuer, have.sqlValuer)
+	assert.Same(t, sqlScanner, have.sqlScanner)
 }
 
 func Test_Single_TagName(t *testing.T) {
@@ -149,6 +158,91 @@ func Test_Single_Value(t *testing.T) {
 	})
 }
 
+func Test_Single_Scan(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		// --- Given ---
+		tag := &Single[int]{name: "name", value: 42}
+
+		// --- When ---
+		err := tag.Scan(44)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 44, tag.value)
+	})
+
+	t.Run("default error - invalid type", func(t *testing.T) {
+		// --- Given ---
+		tag := &Single[int]{name: "name", value: 42}
+
+		// --- When ---
+		err := tag.Scan(int64(44))
+
+		// --- Then ---
+		assert.ErrorEqual(t, "name: invalid element type", err)
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Equal(t, 42, tag.value)
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		// --- Given ---
+		sqlScanner := func(v any) (int, error) {
+			return int(v.(int64)), nil
+		}
+		tag := &Single[int]{name: "name", value: 42, sqlScanner: sqlScanner}
+
+		// --- When ---
+		err := tag.Scan(int64(44))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 44, tag.value)
+	})
+
+	t.Run("null", func(t *testing.T) {
+		// --- Given ---
+		tag := &Single[int]{name: "name", value: 42}
+
+		// --- When ---
+		err := tag.Scan(nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 0, tag.value)
+	})
+
+	t.Run("null with custom scanner", func(t *testing.T) {
+		// --- Given ---
+		sqlScanner := func(v any) (int, error) {
+			return 0, ErrInvType
+		}
+		tag := &Single[int]{name: "name", value: 42, sqlScanner: sqlScanner}
+
+		// --- When ---
+		err := tag.Scan(nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 0, tag.value)
+	})
+
+	t.Run("custom error", func(t *testing.T) {
+		// --- Given ---
+		sqlScanner := func(v any) (int, error) {
+			return 0, ErrInvFormat
+		}
+		tag := &Single[int]{name: "name", value: 42, sqlScanner: sqlScanner}
+
+		// --- When ---
+		err := tag.Scan("abc")
+
+		// --- Then ---
+		assert.ErrorEqual(t, "name: invalid element format", err)
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Equal(t, 42, tag.value)
+	})
+}
+
 func Test_Single_TagEqual(t *testing.T) {
 	tt := []struct {
 		testN string
@@ -251,6 +345,20 @@ func Test_Single_TagSame(t *testing.T) {
 	}
 }
 
+func Test_Single_TagClone(t *testing.T) {
+	// --- Given ---
+	tag := NewSingle("name", 42, KindInt, strconv.Itoa, nil, nil)
+
+	// --- When ---
+	have := tag.TagClone()
+
+	// --- Then ---
+	assert.NotSame(t, tag, have)
+	assert.True(t, tag.TagSame(have))
+	tag.Set(44)
+	assert.Equal(t, 42, have.TagValue())
+}
+
 func Test_Single_String(t *testing.T) {
 	// --- Given ---
 	tag := &Single[int]{value: 42, strValuer: strconv.Itoa}
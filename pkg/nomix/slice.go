@@ -4,7 +4,11 @@
 package nomix
 
 import (
+	"database/sql"
 	"database/sql/driver"
+	"fmt"
+	"iter"
+	"slices"
 
 	"github.com/ctx42/verax/pkg/verax"
 )
@@ -14,15 +18,18 @@ var (
 	_ Tag           = &Slice[int]{}
 	_ ValueComparer = &Slice[int]{}
 	_ Comparer      = &Slice[int]{}
+	_ Cloner        = &Slice[int]{}
+	_ sql.Scanner   = &Slice[int]{}
 )
 
 // Slice is a generic type for multi value [Tag].
 type Slice[T comparable] struct {
-	name      string                          // Tag name.
-	value     []T                             // Tag value.
-	kind      Kind                            // Tag kind.
-	strValuer func([]T) string                // T to string function.
-	sqlValuer func([]T) (driver.Value, error) // T to SQL value function.
+	name       string                          // Tag name.
+	value      []T                             // Tag value.
+	kind       Kind                            // Tag kind.
+	strValuer  func([]T) string                // T to string function.
+	sqlValuer  func([]T) (driver.Value, error) // T to SQL value function.
+	sqlScanner func(any) ([]T, error)          // SQL value to T function.
 }
 
 // NewSlice returns a new instance of [Slice].
@@ -32,14 +39,16 @@ func NewSlice[T comparable](
 	kind Kind,
 	strValuer func([]T) string,
 	sqlValuer func([]T) (driver.Value, error),
+	sqlScanner func(any) ([]T, error),
 ) *Slice[T] {
 
 	return &Slice[T]{
-		name:      name,
-		value:     val,
-		kind:      kind,
-		strValuer: strValuer,
-		sqlValuer: sqlValuer,
+		name:       name,
+		value:      val,
+		kind:       kind,
+		strValuer:  strValuer,
+		sqlValuer:  sqlValuer,
+		sqlScanner: sqlScanner,
 	}
 }
 
@@ -58,6 +67,12 @@ func (tag *Slice[T]) TagSet(v any) error {
 func (tag *Slice[T]) Get() []T  { return tag.value }
 func (tag *Slice[T]) Set(v []T) { tag.value = v }
 
+// All returns an iterator over indexes and elements of the tag value.
+func (tag *Slice[T]) All() iter.Seq2[int, T] { return slices.All(tag.value) }
+
+// Values returns an iterator over elements of the tag value.
+func (tag *Slice[T]) Values() iter.Seq[T] { return slices.Values(tag.value) }
+
 func (tag *Slice[T]) Value() (driver.Value, error) {
 	if tag.sqlValuer == nil {
 		return tag.value, nil
@@ -65,6 +80,31 @@ func (tag *Slice[T]) Value() (driver.Value, error) {
 	return tag.sqlValuer(tag.value)
 }
 
+// Scan implements [sql.Scanner] interface. The NULL database value sets the
+// nil slice, the same way [Single.Scan] sets the zero value. When the type
+// has no SQL scanner defined, then the value must be of the tag's value
+// type. Returns an error prefixed with the tag name if the value cannot be
+// converted.
+func (tag *Slice[T]) Scan(src any) error {
+	if src == nil {
+		tag.value = nil
+		return nil
+	}
+	if tag.sqlScanner == nil {
+		if v, ok := src.([]T); ok {
+			tag.value = v
+			return nil
+		}
+		return fmt.Errorf("%s: %w", tag.name, ErrInvType)
+	}
+	v, err := tag.sqlScanner(src)
+	if err != nil {
+		return fmt.Errorf("%s: %w", tag.name, err)
+	}
+	tag.value = v
+	return nil
+}
+
 func (tag *Slice[T]) TagEqual(other Tag) bool {
 	if other == nil {
 		return false
@@ -106,6 +146,12 @@ func (tag *Slice[T]) TagSame(other Tag) bool {
 	return true
 }
 
+func (tag *Slice[T]) TagClone() Tag {
+	cpy := *tag
+	cpy.value = slices.Clone(tag.value)
+	return &cpy
+}
+
 func (tag *Slice[T]) String() string { return tag.strValuer(tag.value) }
 
 func (tag *Slice[T]) ValidateWith(rule verax.Rule) error {
@@ -0,0 +1,371 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Compile time checks.
+var (
+	_ SliceEncoder = JSONArray{}
+	_ SliceEncoder = PGArray{}
+	_ SliceEncoder = Delimited{}
+)
+
+// SliceEncoder represents the database encoding of slice tag values. Slice
+// tags use it to implement [driver.Valuer] and [sql.Scanner] interfaces.
+type SliceEncoder interface {
+	// SliceEncode encodes slice elements, given as their string
+	// representations, to a single string. When quote is set, the elements
+	// are textual and must be quoted where the encoding requires it.
+	SliceEncode(elems []string, quote bool) (string, error)
+
+	// SliceDecode decodes the string created by SliceEncode to the string
+	// representations of the slice elements. Returns [ErrInvFormat] if the
+	// string is not a valid representation.
+	SliceDecode(val string) ([]string, error)
+}
+
+// JSONArray is the [SliceEncoder] encoding slices as JSON array text, for
+// example: `[1,2]` or `["a","b"]`. It is the default slice encoder.
+type JSONArray struct{}
+
+func (JSONArray) SliceEncode(elems []string, quote bool) (string, error) {
+	buf := &strings.Builder{}
+	buf.WriteByte('[')
+	for i, elem := range elems {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if quote {
+			buf.WriteString(QuoteJSON(elem))
+			continue
+		}
+		buf.WriteString(elem)
+	}
+	buf.WriteByte(']')
+	return buf.String(), nil
+}
+
+// SliceDecode decodes the JSON array text. White space around elements is
+// ignored, quoted elements are unquoted using JSON string rules, and
+// elements which are not quoted are returned as-is.
+func (JSONArray) SliceDecode(val string) ([]string, error) {
+	val = strings.TrimSpace(val)
+	if !strings.HasPrefix(val, "[") || !strings.HasSuffix(val, "]") {
+		return nil, ErrInvFormat
+	}
+	val = val[1 : len(val)-1]
+	if strings.TrimSpace(val) == "" {
+		return []string{}, nil
+	}
+
+	var parts []string
+	var inQuote, escaped bool
+	start := 0
+	for i := 0; i < len(val); i++ {
+		c := val[i]
+		switch {
+		case escaped:
+			escaped = false
+		case inQuote && c == '\\':
+			escaped = true
+		case c == '"':
+			inQuote = !inQuote
+		case !inQuote && c == ',':
+			parts = append(parts, val[start:i])
+			start = i + 1
+		}
+	}
+	if inQuote {
+		return nil, ErrInvFormat
+	}
+	parts = append(parts, val[start:])
+
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil, ErrInvFormat
+		}
+		if part[0] == '"' {
+			if err := json.Unmarshal([]byte(part), &part); err != nil {
+				return nil, ErrInvFormat
+			}
+		} else if strings.ContainsRune(part, '"') {
+			return nil, ErrInvFormat
+		}
+		parts[i] = part
+	}
+	return parts, nil
+}
+
+// PGArray is the [SliceEncoder] encoding slices as PostgreSQL
+// one-dimensional array literals, for example: `{1,2}` or `{"a","b"}`.
+type PGArray struct{}
+
+func (PGArray) SliceEncode(elems []string, quote bool) (string, error) {
+	buf := &strings.Builder{}
+	buf.WriteByte('{')
+	for i, elem := range elems {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if !quote {
+			buf.WriteString(elem)
+			continue
+		}
+		buf.WriteByte('"')
+		for _, r := range elem {
+			if r == '"' || r == '\\' {
+				buf.WriteByte('\\')
+			}
+			buf.WriteRune(r)
+		}
+		buf.WriteByte('"')
+	}
+	buf.WriteByte('}')
+	return buf.String(), nil
+}
+
+// SliceDecode decodes the PostgreSQL array literal. White space around
+// elements is ignored, and backslash escapes are resolved. Returns
+// [ErrInvFormat] for multidimensional arrays and NULL elements, since they
+// cannot be represented by slice tags.
+func (PGArray) SliceDecode(val string) ([]string, error) {
+	val = strings.TrimSpace(val)
+	if !strings.HasPrefix(val, "{") || !strings.HasSuffix(val, "}") {
+		return nil, ErrInvFormat
+	}
+	val = val[1 : len(val)-1]
+	if strings.TrimSpace(val) == "" {
+		return []string{}, nil
+	}
+
+	var parts []string
+	for {
+		part, rest, err := nextPGElem(val)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, part)
+		if rest < 0 {
+			return parts, nil
+		}
+		val = val[rest:]
+	}
+}
+
+// nextPGElem returns the first element of the PostgreSQL array literal
+// without braces and the offset of the remaining elements. The offset is -1
+// when there are no more elements.
+func nextPGElem(val string) (string, int, error) {
+	pos := len(val) - len(strings.TrimLeftFunc(val, unicode.IsSpace))
+	buf := &strings.Builder{}
+
+	if strings.HasPrefix(val[pos:], `"`) {
+		pos++
+		for {
+			if pos >= len(val) {
+				return "", 0, ErrInvFormat
+			}
+			c := val[pos]
+			if c == '\\' && pos+1 < len(val) {
+				buf.WriteByte(val[pos+1])
+				pos += 2
+				continue
+			}
+			pos++
+			if c == '"' {
+				break
+			}
+			buf.WriteByte(c)
+		}
+		rest := strings.TrimLeftFunc(val[pos:], unicode.IsSpace)
+		pos = len(val) - len(rest)
+
+	} else {
+		for pos < len(val) && val[pos] != ',' {
+			c := val[pos]
+			if c == '"' || c == '{' || c == '}' {
+				return "", 0, ErrInvFormat
+			}
+			if c == '\\' && pos+1 < len(val) {
+				pos++
+				c = val[pos]
+			}
+			buf.WriteByte(c)
+			pos++
+		}
+		part := strings.TrimSpace(buf.String())
+		if part == "" || strings.EqualFold(part, "NULL") {
+			return "", 0, ErrInvFormat
+		}
+		buf.Reset()
+		buf.WriteString(part)
+	}
+
+	if pos == len(val) {
+		return buf.String(), -1, nil
+	}
+	if val[pos] != ',' {
+		return "", 0, ErrInvFormat
+	}
+	return buf.String(), pos + 1, nil
+}
+
+// Delimited is the [SliceEncoder] encoding slices as delimited text, for
+// example: `1,2` or `a;"b;c"`.
+type Delimited struct {
+	// Element separator. When empty, the comma is used.
+	Separator string
+
+	// Element quote character. Quoted elements may contain the separator,
+	// and the quote character itself must be doubled. Zero disables quoting.
+	Quote rune
+
+	// When set, white space around elements is trimmed when decoding.
+	Trim bool
+}
+
+// NewDelimited returns a new [Delimited] encoder configured with the
+// [Options.Separator], [Options.Quote] and [Options.Trim] options.
+func NewDelimited(opts ...Option) Delimited {
+	def := NewOptions(opts...)
+	return Delimited{Separator: def.Separator, Quote: def.Quote, Trim: def.Trim}
+}
+
+// SliceEncode encodes the elements as delimited text. Elements are quoted
+// only when it is required to decode them back. Returns [ErrInvValue] when
+// quoting is required but disabled.
+func (enc Delimited) SliceEncode(elems []string, _ bool) (string, error) {
+	sep := enc.separator()
+	buf := &strings.Builder{}
+	for i, elem := range elems {
+		if i > 0 {
+			buf.WriteString(sep)
+		}
+		if !enc.needsQuote(elem, len(elems)) {
+			buf.WriteString(elem)
+			continue
+		}
+		if enc.Quote == 0 {
+			return "", ErrInvValue
+		}
+		quote := string(enc.Quote)
+		buf.WriteString(quote)
+		buf.WriteString(strings.ReplaceAll(elem, quote, quote+quote))
+		buf.WriteString(quote)
+	}
+	return buf.String(), nil
+}
+
+// needsQuote returns true if the element must be quoted to be decoded back.
+func (enc Delimited) needsQuote(elem string, cnt int) bool {
+	if elem == "" {
+		return cnt == 1
+	}
+	if strings.Contains(elem, enc.separator()) {
+		return true
+	}
+	if enc.Quote != 0 && strings.ContainsRune(elem, enc.Quote) {
+		return true
+	}
+	return enc.Trim && strings.TrimSpace(elem) != elem
+}
+
+// SliceDecode decodes the delimited text. Returns an empty slice for an
+// empty string.
+func (enc Delimited) SliceDecode(val string) ([]string, error) {
+	if val == "" {
+		return []string{}, nil
+	}
+	sep := enc.separator()
+
+	var parts []string
+	for {
+		part, rest, err := enc.next(val, sep)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, part)
+		if rest < 0 {
+			return parts, nil
+		}
+		val = val[rest:]
+	}
+}
+
+// next returns the first element of the delimited text and the offset of
+// the remaining elements. The offset is -1 when there are no more elements.
+func (enc Delimited) next(val, sep string) (string, int, error) {
+	lead := 0
+	if enc.Trim {
+		lead = len(val) - len(strings.TrimLeftFunc(val, unicode.IsSpace))
+	}
+	if enc.Quote == 0 || !strings.HasPrefix(val[lead:], string(enc.Quote)) {
+		raw, _, found := strings.Cut(val, sep)
+		part := raw
+		if enc.Trim {
+			part = strings.TrimSpace(raw)
+		}
+		if !found {
+			return part, -1, nil
+		}
+		return part, len(raw) + len(sep), nil
+	}
+
+	quote := string(enc.Quote)
+	qLen := utf8.RuneLen(enc.Quote)
+	buf := &strings.Builder{}
+	pos := lead + qLen
+	for {
+		idx := strings.Index(val[pos:], quote)
+		if idx < 0 {
+			return "", 0, ErrInvFormat
+		}
+		buf.WriteString(val[pos : pos+idx])
+		pos += idx + qLen
+		if strings.HasPrefix(val[pos:], quote) {
+			buf.WriteString(quote)
+			pos += qLen
+			continue
+		}
+		break
+	}
+
+	if enc.Trim {
+		rest := strings.TrimLeftFunc(val[pos:], unicode.IsSpace)
+		pos = len(val) - len(rest)
+	}
+	if pos == len(val) {
+		return buf.String(), -1, nil
+	}
+	if !strings.HasPrefix(val[pos:], sep) {
+		return "", 0, ErrInvFormat
+	}
+	return buf.String(), pos + len(sep), nil
+}
+
+// separator returns the element separator.
+func (enc Delimited) separator() string {
+	if enc.Separator == "" {
+		return ","
+	}
+	return enc.Separator
+}
+
+// QuoteJSON returns the JSON string representation of the value without
+// escaping HTML characters.
+func QuoteJSON(v string) string {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(v) // Encoding a string never fails.
+	return strings.TrimSuffix(buf.String(), "\n")
+}
@@ -0,0 +1,372 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_JSONArray_SliceEncode_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		elems []string
+		quote bool
+		exp   string
+	}{
+		{"nil", nil, false, `[]`},
+		{"empty", []string{}, true, `[]`},
+		{"not quoted", []string{"1", "2"}, false, `[1,2]`},
+		{"quoted", []string{"a", "b"}, true, `["a","b"]`},
+		{"escaped", []string{`a"b`, "<c>"}, true, `["a\"b","<c>"]`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := JSONArray{}.SliceEncode(tc.elems, tc.quote)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_JSONArray_SliceDecode_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val string
+		exp []string
+	}{
+		{"empty", "[]", []string{}},
+		{"empty with spaces", " [ ] ", []string{}},
+		{"not quoted", "[1, 2]", []string{"1", "2"}},
+		{"quoted", `["a", "b"]`, []string{"a", "b"}},
+		{"quoted with comma", `["a,b", "c"]`, []string{"a,b", "c"}},
+		{"escaped", `["a\"b", "A"]`, []string{`a"b`, "A"}},
+		{"empty string", `[""]`, []string{""}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := JSONArray{}.SliceDecode(tc.val)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_JSONArray_SliceDecode_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val string
+	}{
+		{"empty string", ""},
+		{"not an array", "1,2"},
+		{"not closed", "[1, 2"},
+		{"not closed quote", `["a]`},
+		{"empty element", `["a",,"b"]`},
+		{"trailing comma", `["a",]`},
+		{"quote inside element", `[a"b]`},
+		{"invalid escape", `["\x"]`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := JSONArray{}.SliceDecode(tc.val)
+
+			// --- Then ---
+			assert.ErrorIs(t, ErrInvFormat, err)
+			assert.Nil(t, have)
+		})
+	}
+}
+
+func Test_PGArray_SliceEncode_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		elems []string
+		quote bool
+		exp   string
+	}{
+		{"nil", nil, false, `{}`},
+		{"empty", []string{}, true, `{}`},
+		{"not quoted", []string{"1", "2"}, false, `{1,2}`},
+		{"quoted", []string{"a", "b"}, true, `{"a","b"}`},
+		{"escaped", []string{`a"b`, `c\d`}, true, `{"a\"b","c\\d"}`},
+		{"empty string", []string{""}, true, `{""}`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := PGArray{}.SliceEncode(tc.elems, tc.quote)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_PGArray_SliceDecode_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val string
+		exp []string
+	}{
+		{"empty", "{}", []string{}},
+		{"empty with spaces", " { } ", []string{}},
+		{"not quoted", "{1,2}", []string{"1", "2"}},
+		{"not quoted with spaces", "{ 1 , 2 }", []string{"1", "2"}},
+		{"quoted", `{"a","b"}`, []string{"a", "b"}},
+		{"quoted with spaces", `{ "a" , "b" }`, []string{"a", "b"}},
+		{"quoted with comma", `{"a,b",c}`, []string{"a,b", "c"}},
+		{"escaped", `{"a\"b","c\\d"}`, []string{`a"b`, `c\d`}},
+		{"escaped not quoted", `{a\,b}`, []string{"a,b"}},
+		{"empty string", `{""}`, []string{""}},
+		{"quoted null", `{"NULL"}`, []string{"NULL"}},
+		{"multi byte", `{"zażółć",gęślą}`, []string{"zażółć", "gęślą"}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := PGArray{}.SliceDecode(tc.val)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_PGArray_SliceDecode_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val string
+	}{
+		{"empty string", ""},
+		{"not an array", "1,2"},
+		{"not closed", "{1,2"},
+		{"not closed quote", `{"a}`},
+		{"empty element", `{a,,b}`},
+		{"trailing comma", `{a,}`},
+		{"null element", `{a,NULL}`},
+		{"multidimensional", `{{1,2},{3,4}}`},
+		{"quote inside element", `{a"b}`},
+		{"text after quote", `{"a"b}`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := PGArray{}.SliceDecode(tc.val)
+
+			// --- Then ---
+			assert.ErrorIs(t, ErrInvFormat, err)
+			assert.Nil(t, have)
+		})
+	}
+}
+
+func Test_PGArray_round_trip(t *testing.T) {
+	// --- Given ---
+	elems := []string{"", `a"b`, `c\d`, "e,f", "{g}", " h "}
+	enc := PGArray{}
+
+	// --- When ---
+	val, err := enc.SliceEncode(elems, true)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	have, err := enc.SliceDecode(val)
+	assert.NoError(t, err)
+	assert.Equal(t, elems, have)
+}
+
+func Test_NewDelimited(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		// --- When ---
+		have := NewDelimited()
+
+		// --- Then ---
+		assert.Equal(t, ",", have.Separator)
+		assert.Equal(t, '"', have.Quote)
+		assert.True(t, have.Trim)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- When ---
+		have := NewDelimited(WithSeparator(";"), WithQuote(0), WithNoTrim)
+
+		// --- Then ---
+		assert.Equal(t, ";", have.Separator)
+		assert.Equal(t, rune(0), have.Quote)
+		assert.False(t, have.Trim)
+	})
+}
+
+func Test_Delimited_SliceEncode_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		enc   Delimited
+		elems []string
+		exp   string
+	}{
+		{"nil", NewDelimited(), nil, ""},
+		{"simple", NewDelimited(), []string{"a", "b"}, "a,b"},
+		{"empty separator", Delimited{}, []string{"a", "b"}, "a,b"},
+		{"custom separator", Delimited{Separator: "; "}, []string{"a"}, "a"},
+		{"contains separator", NewDelimited(), []string{"a,b"}, `"a,b"`},
+		{"contains quote", NewDelimited(), []string{`a"b`}, `"a""b"`},
+		{"leading space", NewDelimited(), []string{" a"}, `" a"`},
+		{"leading space no trim", Delimited{}, []string{" a"}, " a"},
+		{"single empty", NewDelimited(), []string{""}, `""`},
+		{"many empty", NewDelimited(), []string{"", ""}, ","},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := tc.enc.SliceEncode(tc.elems, true)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_Delimited_SliceEncode(t *testing.T) {
+	t.Run("error - quoting required but disabled", func(t *testing.T) {
+		// --- Given ---
+		enc := NewDelimited(WithQuote(0))
+
+		// --- When ---
+		have, err := enc.SliceEncode([]string{"a,b"}, true)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvValue, err)
+		assert.Equal(t, "", have)
+	})
+}
+
+func Test_Delimited_SliceDecode_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		enc Delimited
+		val string
+		exp []string
+	}{
+		{"empty", NewDelimited(), "", []string{}},
+		{"single", NewDelimited(), "a", []string{"a"}},
+		{"simple", NewDelimited(), "a,b", []string{"a", "b"}},
+		{"trimmed", NewDelimited(), " a , b ", []string{"a", "b"}},
+		{"not trimmed", Delimited{}, " a , b ", []string{" a ", " b "}},
+		{"empty element", NewDelimited(), "a,,b", []string{"a", "", "b"}},
+		{"quoted", NewDelimited(), `"a,b",c`, []string{"a,b", "c"}},
+		{"doubled quote", NewDelimited(), `"a""b",c`, []string{`a"b`, "c"}},
+		{"quoted last", NewDelimited(), `a,"b,c"`, []string{"a", "b,c"}},
+		{"quoted empty", NewDelimited(), `""`, []string{""}},
+		{
+			"multi char separator",
+			Delimited{Separator: "::"},
+			"a::b",
+			[]string{"a", "b"},
+		},
+		{
+			"custom quote",
+			Delimited{Separator: ",", Quote: '\''},
+			"'a,b',c",
+			[]string{"a,b", "c"},
+		},
+		{
+			"quoting disabled",
+			Delimited{Separator: ","},
+			`"a,b"`,
+			[]string{`"a`, `b"`},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := tc.enc.SliceDecode(tc.val)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_Delimited_SliceDecode_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val string
+	}{
+		{"not closed quote", `"a,b`},
+		{"text after quote", `"a"b,c`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := NewDelimited().SliceDecode(tc.val)
+
+			// --- Then ---
+			assert.ErrorIs(t, ErrInvFormat, err)
+			assert.Nil(t, have)
+		})
+	}
+}
+
+func Test_Delimited_round_trip(t *testing.T) {
+	// --- Given ---
+	elems := []string{"", `a"b`, "c,d", " e ", "f"}
+	enc := NewDelimited()
+
+	// --- When ---
+	val, err := enc.SliceEncode(elems, true)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	have, err := enc.SliceDecode(val)
+	assert.NoError(t, err)
+	assert.Equal(t, elems, have)
+}
+
+func Test_QuoteJSON(t *testing.T) {
+	t.Run("simple", func(t *testing.T) {
+		// --- When ---
+		have := QuoteJSON("abc")
+
+		// --- Then ---
+		assert.Equal(t, `"abc"`, have)
+	})
+
+	t.Run("escaped", func(t *testing.T) {
+		// --- When ---
+		have := QuoteJSON("a\"b\n<c>")
+
+		// --- Then ---
+		assert.Equal(t, `"a\"b\n<c>"`, have)
+	})
+}
@@ -17,9 +17,17 @@ func Test_NewSlice(t *testing.T) {
 	// --- Given ---
 	strValuer := func(v []int) string { return fmt.Sprint(v) }
 	sqlValuer := func(v []int) (driver.Value, error) { return v, nil }
+	sqlScanner := func(v any) ([]int, error) { return nil, nil }
 
 	// --- When ---
-	have := NewSlice[int]("name", []int{42, 44}, KindInt, strValuer, sqlValuer)
+	have := NewSlice(
+		"name",
+		[]int{42, 44},
+		KindInt,
+		strValuer,
+		sqlValuer,
+		sqlScanner,
+	)
 
 	// --- Then ---
 	assert.Equal(t, "name", have.name)
@@ -27,6 +35,7 @@ func Test_NewSlice(t *testing.T) {
 	assert.Equal(t, KindInt, have.kind)
 	assert.Same(t, strValuer, have.strValuer)
 	assert.Same(t, sqlValuer, have.sqlValuer)
+	assert.Same(t, sqlScanner, have.sqlScanner)
 }
 
 func Test_Slice_TagName(t *testing.T) {
@@ -153,6 +162,99 @@ func Test_Slice_Value(t *testing.T) {
 	})
 }
 
+func Test_Slice_Scan(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		// --- Given ---
+		tag := &Slice[int]{name: "name", value: []int{42}}
+
+		// --- When ---
+		err := tag.Scan([]int{44, 45})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []int{44, 45}, tag.value)
+	})
+
+	t.Run("default error - invalid type", func(t *testing.T) {
+		// --- Given ---
+		tag := &Slice[int]{name: "name", value: []int{42}}
+
+		// --- When ---
+		err := tag.Scan("[44, 45]")
+
+		// --- Then ---
+		assert.ErrorEqual(t, "name: invalid element type", err)
+		assert.ErrorIs(t, ErrInvType, err)
+		assert.Equal(t, []int{42}, tag.value)
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		// --- Given ---
+		sqlScanner := func(v any) ([]int, error) {
+			return []int{int(v.(int64))}, nil
+		}
+		tag := &Slice[int]{name: "name", sqlScanner: sqlScanner}
+
+		// --- When ---
+		err := tag.Scan(int64(44))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []int{44}, tag.value)
+	})
+
+	t.Run("null", func(t *testing.T) {
+		// --- Given ---
+		tag := &Slice[int]{name: "name", value: []int{42}}
+
+		// --- When ---
+		err := tag.Scan(nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, tag.value)
+	})
+
+	t.Run("null with custom scanner", func(t *testing.T) {
+		// --- Given ---
+		sqlScanner := func(v any) ([]int, error) {
+			return nil, ErrInvType
+		}
+		tag := &Slice[int]{
+			name:       "name",
+			value:      []int{42},
+			sqlScanner: sqlScanner,
+		}
+
+		// --- When ---
+		err := tag.Scan(nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, tag.value)
+	})
+
+	t.Run("custom error", func(t *testing.T) {
+		// --- Given ---
+		sqlScanner := func(v any) ([]int, error) {
+			return nil, ErrInvFormat
+		}
+		tag := &Slice[int]{
+			name:       "name",
+			value:      []int{42},
+			sqlScanner: sqlScanner,
+		}
+
+		// --- When ---
+		err := tag.Scan("abc")
+
+		// --- Then ---
+		assert.ErrorEqual(t, "name: invalid element format", err)
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Equal(t, []int{42}, tag.value)
+	})
+}
+
 func Test_Slice_TagEqual(t *testing.T) {
 	tt := []struct {
 		testN string
@@ -279,6 +381,82 @@ func Test_Slice_TagSame(t *testing.T) {
 	}
 }
 
+func Test_Slice_All(t *testing.T) {
+	t.Run("all", func(t *testing.T) {
+		// --- Given ---
+		tag := NewSlice("name", []int{42, 44}, KindIntSlice, nil, nil, nil)
+
+		// --- When ---
+		var idx, val []int
+		for i, v := range tag.All() {
+			idx = append(idx, i)
+			val = append(val, v)
+		}
+
+		// --- Then ---
+		assert.Equal(t, []int{0, 1}, idx)
+		assert.Equal(t, []int{42, 44}, val)
+	})
+
+	t.Run("nil value", func(t *testing.T) {
+		// --- Given ---
+		tag := NewSlice[int]("name", nil, KindIntSlice, nil, nil, nil)
+
+		// --- When ---
+		var cnt int
+		for range tag.All() {
+			cnt++
+		}
+
+		// --- Then ---
+		assert.Equal(t, 0, cnt)
+	})
+}
+
+func Test_Slice_Values(t *testing.T) {
+	// --- Given ---
+	tag := NewSlice("name", []int{42, 44, 46}, KindIntSlice, nil, nil, nil)
+
+	// --- When ---
+	var have []int
+	for v := range tag.Values() {
+		if v == 46 {
+			break
+		}
+		have = append(have, v)
+	}
+
+	// --- Then ---
+	assert.Equal(t, []int{42, 44}, have)
+}
+
+func Test_Slice_TagClone(t *testing.T) {
+	t.Run("clone", func(t *testing.T) {
+		// --- Given ---
+		tag := NewSlice("name", []int{42, 44}, KindIntSlice, nil, nil, nil)
+
+		// --- When ---
+		have := tag.TagClone()
+
+		// --- Then ---
+		assert.NotSame(t, tag, have)
+		assert.True(t, tag.TagSame(have))
+		tag.Get()[0] = 1
+		assert.Equal(t, []int{42, 44}, have.TagValue())
+	})
+
+	t.Run("nil value", func(t *testing.T) {
+		// --- Given ---
+		tag := NewSlice[int]("name", nil, KindIntSlice, nil, nil, nil)
+
+		// --- When ---
+		have := tag.TagClone()
+
+		// --- Then ---
+		assert.Nil(t, have.TagValue())
+	})
+}
+
 func Test_Slice_String(t *testing.T) {
 	t.Run("error - nil value", func(t *testing.T) {
 		// --- Given ---
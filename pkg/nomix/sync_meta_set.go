@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"maps"
+	"reflect"
+	"sync"
+)
+
+// Compile time checks.
+var (
+	_ Metadata      = &SyncMetaSet{}
+	_ MetaAllGetter = &SyncMetaSet{}
+)
+
+// SyncMetaSet represents a set of metadata key-values safe for concurrent
+// use.
+type SyncMetaSet struct {
+	mx sync.RWMutex
+	m  map[string]any
+}
+
+// NewSyncMetaSet returns a new [SyncMetaSet] instance. It accepts the same
+// options as [NewMetaSet]. The initial map is copied, so it is not accessed
+// without the lock.
+func NewSyncMetaSet(opts ...Option) *SyncMetaSet {
+	return &SyncMetaSet{m: maps.Clone(NewMetaSet(opts...).m)}
+}
+
+func (set *SyncMetaSet) MetaGet(key string) any {
+	set.mx.RLock()
+	defer set.mx.RUnlock()
+	return set.m[key]
+}
+
+func (set *SyncMetaSet) MetaSet(key string, value any) {
+	if value == nil {
+		return
+	}
+	set.mx.Lock()
+	defer set.mx.Unlock()
+	set.m[key] = value
+}
+
+func (set *SyncMetaSet) MetaDelete(key string) {
+	set.mx.Lock()
+	defer set.mx.Unlock()
+	delete(set.m, key)
+}
+
+// MetaCount returns the number of entries in the metadata set.
+func (set *SyncMetaSet) MetaCount() int {
+	set.mx.RLock()
+	defer set.mx.RUnlock()
+	return len(set.m)
+}
+
+// MetaGetAll returns a copy of all metadata in the set. The returned map is
+// safe to use while the set is modified.
+func (set *SyncMetaSet) MetaGetAll() map[string]any {
+	set.mx.RLock()
+	defer set.mx.RUnlock()
+	return maps.Clone(set.m)
+}
+
+// MetaDeleteAll deletes all metadata from the set.
+func (set *SyncMetaSet) MetaDeleteAll() {
+	set.mx.Lock()
+	defer set.mx.Unlock()
+	clear(set.m)
+}
+
+// MetaSnapshot returns a [MetaSet] with a copy of all metadata in the set.
+// The snapshot is safe to iterate and modify while the set is modified.
+func (set *SyncMetaSet) MetaSnapshot() MetaSet {
+	return MetaSet{m: set.MetaGetAll()}
+}
+
+// MetaCompareAndSet atomically replaces the named value with the "nev" value
+// if the current value is deeply equal to the "old" value. Use nil "old" to
+// require the value doesn't exist and nil "nev" to delete it. Returns true
+// if the set was changed.
+func (set *SyncMetaSet) MetaCompareAndSet(key string, old, nev any) bool {
+	set.mx.Lock()
+	defer set.mx.Unlock()
+	if !reflect.DeepEqual(set.m[key], old) {
+		return false
+	}
+	if nev == nil {
+		delete(set.m, key)
+		return true
+	}
+	set.m[key] = nev
+	return true
+}
+
+// MetaUpdate atomically replaces the named value with the one returned by
+// the function. The function is called with the current value, or nil if it
+// doesn't exist, and may return nil to delete it. Returns the value
+// returned by the function.
+//
+// The function is called with the set locked, so it must not call the set
+// methods.
+func (set *SyncMetaSet) MetaUpdate(key string, fn func(any) any) any {
+	set.mx.Lock()
+	defer set.mx.Unlock()
+	val := fn(set.m[key])
+	if val == nil {
+		delete(set.m, key)
+		return nil
+	}
+	set.m[key] = val
+	return val
+}
@@ -0,0 +1,287 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_NewSyncMetaSet(t *testing.T) {
+	t.Run("no options", func(t *testing.T) {
+		// --- When ---
+		have := NewSyncMetaSet()
+
+		// --- Then ---
+		assert.NotNil(t, have.m)
+		assert.Len(t, 0, have.m)
+	})
+
+	t.Run("with the initial map", func(t *testing.T) {
+		// --- Given ---
+		m := map[string]any{"A": 1, "B": nil}
+
+		// --- When ---
+		have := NewSyncMetaSet(WithMeta(m))
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": 1}, have.m)
+		assert.NotSame(t, m, have.m)
+	})
+}
+
+func Test_SyncMetaSet_MetaGet(t *testing.T) {
+	t.Run("existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncMetaSet(WithMeta(map[string]any{"A": 1}))
+
+		// --- When ---
+		have := set.MetaGet("A")
+
+		// --- Then ---
+		assert.Equal(t, 1, have)
+	})
+
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncMetaSet()
+
+		// --- When ---
+		have := set.MetaGet("A")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_SyncMetaSet_MetaSet(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncMetaSet()
+
+		// --- When ---
+		set.MetaSet("A", 1)
+
+		// --- Then ---
+		assert.Equal(t, 1, set.MetaGet("A"))
+	})
+
+	t.Run("nil value", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncMetaSet()
+
+		// --- When ---
+		set.MetaSet("A", nil)
+
+		// --- Then ---
+		assert.Equal(t, 0, set.MetaCount())
+	})
+}
+
+func Test_SyncMetaSet_MetaDelete(t *testing.T) {
+	// --- Given ---
+	set := NewSyncMetaSet(WithMeta(map[string]any{"A": 1, "B": 2}))
+
+	// --- When ---
+	set.MetaDelete("A")
+
+	// --- Then ---
+	assert.Equal(t, map[string]any{"B": 2}, set.MetaGetAll())
+}
+
+func Test_SyncMetaSet_MetaDeleteAll(t *testing.T) {
+	// --- Given ---
+	set := NewSyncMetaSet(WithMeta(map[string]any{"A": 1, "B": 2}))
+
+	// --- When ---
+	set.MetaDeleteAll()
+
+	// --- Then ---
+	assert.Equal(t, 0, set.MetaCount())
+}
+
+func Test_SyncMetaSet_MetaGetAll(t *testing.T) {
+	// --- Given ---
+	set := NewSyncMetaSet(WithMeta(map[string]any{"A": 1}))
+
+	// --- When ---
+	have := set.MetaGetAll()
+
+	// --- Then ---
+	assert.Equal(t, map[string]any{"A": 1}, have)
+	assert.NotSame(t, set.m, have)
+	set.MetaSet("B", 2)
+	assert.Len(t, 1, have)
+}
+
+func Test_SyncMetaSet_MetaSnapshot(t *testing.T) {
+	// --- Given ---
+	set := NewSyncMetaSet(WithMeta(map[string]any{"A": 1}))
+
+	// --- When ---
+	have := set.MetaSnapshot()
+
+	// --- Then ---
+	set.MetaSet("B", 2)
+	have.MetaSet("C", 3)
+	assert.Equal(t, map[string]any{"A": 1, "C": 3}, have.MetaGetAll())
+	assert.Equal(t, map[string]any{"A": 1, "B": 2}, set.MetaGetAll())
+}
+
+func Test_SyncMetaSet_MetaCompareAndSet(t *testing.T) {
+	t.Run("set when equal", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncMetaSet(WithMeta(map[string]any{"A": 1}))
+
+		// --- When ---
+		have := set.MetaCompareAndSet("A", 1, 2)
+
+		// --- Then ---
+		assert.True(t, have)
+		assert.Equal(t, 2, set.MetaGet("A"))
+	})
+
+	t.Run("set when deeply equal", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncMetaSet(WithMeta(map[string]any{"A": []int{1}}))
+
+		// --- When ---
+		have := set.MetaCompareAndSet("A", []int{1}, []int{1, 2})
+
+		// --- Then ---
+		assert.True(t, have)
+		assert.Equal(t, []int{1, 2}, set.MetaGet("A"))
+	})
+
+	t.Run("not set when modified", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncMetaSet(WithMeta(map[string]any{"A": 3}))
+
+		// --- When ---
+		have := set.MetaCompareAndSet("A", 1, 2)
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.Equal(t, 3, set.MetaGet("A"))
+	})
+
+	t.Run("not set when type changed", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncMetaSet(WithMeta(map[string]any{"A": int64(1)}))
+
+		// --- When ---
+		have := set.MetaCompareAndSet("A", 1, 2)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("add when not existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncMetaSet()
+
+		// --- When ---
+		have := set.MetaCompareAndSet("A", nil, 1)
+
+		// --- Then ---
+		assert.True(t, have)
+		assert.Equal(t, 1, set.MetaGet("A"))
+	})
+
+	t.Run("not added when existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncMetaSet(WithMeta(map[string]any{"A": 1}))
+
+		// --- When ---
+		have := set.MetaCompareAndSet("A", nil, 2)
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.Equal(t, 1, set.MetaGet("A"))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncMetaSet(WithMeta(map[string]any{"A": 1}))
+
+		// --- When ---
+		have := set.MetaCompareAndSet("A", 1, nil)
+
+		// --- Then ---
+		assert.True(t, have)
+		assert.Equal(t, 0, set.MetaCount())
+	})
+}
+
+func Test_SyncMetaSet_MetaUpdate(t *testing.T) {
+	t.Run("update", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncMetaSet(WithMeta(map[string]any{"A": 1}))
+
+		// --- When ---
+		have := set.MetaUpdate("A", func(cur any) any { return cur.(int) + 1 })
+
+		// --- Then ---
+		assert.Equal(t, 2, have)
+		assert.Equal(t, 2, set.MetaGet("A"))
+	})
+
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncMetaSet()
+		var got any = 0
+
+		// --- When ---
+		have := set.MetaUpdate("A", func(cur any) any {
+			got = cur
+			return 1
+		})
+
+		// --- Then ---
+		assert.Nil(t, got)
+		assert.Equal(t, 1, have)
+		assert.Equal(t, 1, set.MetaGet("A"))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncMetaSet(WithMeta(map[string]any{"A": 1}))
+
+		// --- When ---
+		have := set.MetaUpdate("A", func(any) any { return nil })
+
+		// --- Then ---
+		assert.Nil(t, have)
+		assert.Equal(t, 0, set.MetaCount())
+	})
+}
+
+func Test_SyncMetaSet_concurrent(t *testing.T) {
+	// --- Given ---
+	set := NewSyncMetaSet(WithMeta(map[string]any{"A": 0}))
+	wg := sync.WaitGroup{}
+
+	// --- When ---
+	for range 10 {
+		wg.Go(func() {
+			for range 100 {
+				for {
+					cur := set.MetaGet("A")
+					if set.MetaCompareAndSet("A", cur, cur.(int)+1) {
+						break
+					}
+				}
+				for range set.MetaSnapshot().MetaGetAll() {
+					set.MetaSet("B", 1)
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	// --- Then ---
+	assert.Equal(t, 1000, set.MetaGet("A"))
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"maps"
+	"sync"
+)
+
+// Compile time checks.
+var (
+	_ Tagger    = &SyncTagSet{}
+	_ AllGetter = &SyncTagSet{}
+)
+
+// SyncTagSet represents a set of tags safe for concurrent use.
+type SyncTagSet struct {
+	mx sync.RWMutex
+	m  map[string]Tag
+}
+
+// NewSyncTagSet returns a new instance of [SyncTagSet]. It accepts the same
+// options as [NewTagSet]. The initial map is copied, so it is not accessed
+// without the lock.
+func NewSyncTagSet(opts ...Option) *SyncTagSet {
+	return &SyncTagSet{m: maps.Clone(NewTagSet(opts...).m)}
+}
+
+func (set *SyncTagSet) TagGet(name string) Tag {
+	set.mx.RLock()
+	defer set.mx.RUnlock()
+	return set.m[name]
+}
+
+func (set *SyncTagSet) TagSet(tags ...Tag) {
+	set.mx.Lock()
+	defer set.mx.Unlock()
+	for _, tag := range tags {
+		if tag == nil {
+			continue
+		}
+		set.m[tag.TagName()] = tag
+	}
+}
+
+func (set *SyncTagSet) TagDelete(name string) {
+	set.mx.Lock()
+	defer set.mx.Unlock()
+	delete(set.m, name)
+}
+
+// TagCount returns the number of entries in the tag set.
+func (set *SyncTagSet) TagCount() int {
+	set.mx.RLock()
+	defer set.mx.RUnlock()
+	return len(set.m)
+}
+
+// TagGetAll returns a copy of all tags in the set. The returned map is safe to
+// use while the set is modified.
+func (set *SyncTagSet) TagGetAll() map[string]Tag {
+	set.mx.RLock()
+	defer set.mx.RUnlock()
+	return maps.Clone(set.m)
+}
+
+// TagDeleteAll deletes all tags from the set.
+func (set *SyncTagSet) TagDeleteAll() {
+	set.mx.Lock()
+	defer set.mx.Unlock()
+	clear(set.m)
+}
+
+func (set *SyncTagSet) MetaGetAll() map[string]any {
+	set.mx.RLock()
+	defer set.mx.RUnlock()
+	return TagSet{m: set.m}.MetaGetAll()
+}
+
+// TagSnapshot returns a [TagSet] with a copy of all tags in the set. The
+// snapshot is safe to iterate and modify while the set is modified.
+func (set *SyncTagSet) TagSnapshot() TagSet {
+	return TagSet{m: set.TagGetAll()}
+}
+
+// TagCompareAndSet atomically replaces the named tag with the "nev" tag if the
+// current tag has the same kind and value as the "old" tag. Use nil "old" to
+// require the tag doesn't exist and nil "nev" to delete it. Returns true if
+// the set was changed. The "nev" tag must have the given name, otherwise, the
+// method returns false.
+func (set *SyncTagSet) TagCompareAndSet(name string, old, nev Tag) bool {
+	if nev != nil && nev.TagName() != name {
+		return false
+	}
+	set.mx.Lock()
+	defer set.mx.Unlock()
+	cur := set.m[name]
+	if (cur == nil) != (old == nil) {
+		return false
+	}
+	if cur != nil && !tagEqual(cur, old) {
+		return false
+	}
+	if nev == nil {
+		delete(set.m, name)
+		return true
+	}
+	set.m[name] = nev
+	return true
+}
+
+// TagUpdate atomically replaces the named tag with the one returned by the
+// function. The function is called with the current tag, or nil if it
+// doesn't exist, and must return the tag with the same name, or nil to
+// delete it. Returns the tag returned by the function. When the returned tag
+// has a different name, the set is not changed, and the current tag is
+// returned.
+//
+// The function is called with the set locked, so it must not call the set
+// methods.
+func (set *SyncTagSet) TagUpdate(name string, fn func(Tag) Tag) Tag {
+	set.mx.Lock()
+	defer set.mx.Unlock()
+	cur := set.m[name]
+	tag := fn(cur)
+	if tag == nil {
+		delete(set.m, name)
+		return nil
+	}
+	if tag.TagName() != name {
+		return cur
+	}
+	set.m[name] = tag
+	return tag
+}
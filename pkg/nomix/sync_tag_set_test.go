@@ -0,0 +1,356 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_NewSyncTagSet(t *testing.T) {
+	t.Run("no options", func(t *testing.T) {
+		// --- When ---
+		have := NewSyncTagSet()
+
+		// --- Then ---
+		assert.NotNil(t, have.m)
+		assert.Len(t, 0, have.m)
+	})
+
+	t.Run("with the initial map", func(t *testing.T) {
+		// --- Given ---
+		tag := tstInt("A", 1)
+		m := map[string]Tag{"A": tag, "B": nil}
+
+		// --- When ---
+		have := NewSyncTagSet(WithTags(m))
+
+		// --- Then ---
+		assert.Equal(t, map[string]Tag{"A": tag}, have.m)
+		assert.NotSame(t, m, have.m)
+	})
+}
+
+func Test_SyncTagSet_TagGet(t *testing.T) {
+	t.Run("existing", func(t *testing.T) {
+		// --- Given ---
+		tag := tstInt("A", 1)
+		set := NewSyncTagSet()
+		set.TagSet(tag)
+
+		// --- When ---
+		have := set.TagGet("A")
+
+		// --- Then ---
+		assert.Same(t, tag, have)
+	})
+
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncTagSet()
+
+		// --- When ---
+		have := set.TagGet("A")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_SyncTagSet_TagSet(t *testing.T) {
+	// --- Given ---
+	set := NewSyncTagSet()
+
+	// --- When ---
+	set.TagSet(tstInt("A", 1), nil, tstStr("B", "b"))
+
+	// --- Then ---
+	assert.Equal(t, 2, set.TagCount())
+}
+
+func Test_SyncTagSet_TagDelete(t *testing.T) {
+	// --- Given ---
+	set := NewSyncTagSet()
+	set.TagSet(tstInt("A", 1), tstInt("B", 2))
+
+	// --- When ---
+	set.TagDelete("A")
+
+	// --- Then ---
+	assert.Nil(t, set.TagGet("A"))
+	assert.Equal(t, 1, set.TagCount())
+}
+
+func Test_SyncTagSet_TagDeleteAll(t *testing.T) {
+	// --- Given ---
+	set := NewSyncTagSet()
+	set.TagSet(tstInt("A", 1), tstInt("B", 2))
+
+	// --- When ---
+	set.TagDeleteAll()
+
+	// --- Then ---
+	assert.Equal(t, 0, set.TagCount())
+}
+
+func Test_SyncTagSet_TagGetAll(t *testing.T) {
+	// --- Given ---
+	set := NewSyncTagSet()
+	set.TagSet(tstInt("A", 1))
+
+	// --- When ---
+	have := set.TagGetAll()
+
+	// --- Then ---
+	assert.Len(t, 1, have)
+	assert.NotSame(t, set.m, have)
+	set.TagSet(tstInt("B", 2))
+	assert.Len(t, 1, have)
+}
+
+func Test_SyncTagSet_MetaGetAll(t *testing.T) {
+	t.Run("values", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncTagSet()
+		set.TagSet(tstInt("A", 1), tstStr("B", "b"))
+
+		// --- When ---
+		have := set.MetaGetAll()
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": 1, "B": "b"}, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncTagSet()
+
+		// --- When ---
+		have := set.MetaGetAll()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_SyncTagSet_TagSnapshot(t *testing.T) {
+	// --- Given ---
+	set := NewSyncTagSet()
+	set.TagSet(tstInt("A", 1))
+
+	// --- When ---
+	have := set.TagSnapshot()
+
+	// --- Then ---
+	set.TagSet(tstInt("B", 2))
+	have.TagSet(tstInt("C", 3))
+	assert.Equal(t, 2, have.TagCount())
+	assert.Nil(t, have.TagGet("B"))
+	assert.Nil(t, set.TagGet("C"))
+}
+
+func Test_SyncTagSet_TagCompareAndSet(t *testing.T) {
+	t.Run("set when equal", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncTagSet()
+		set.TagSet(tstInt("A", 1))
+		nev := tstInt("A", 2)
+
+		// --- When ---
+		have := set.TagCompareAndSet("A", tstInt("A", 1), nev)
+
+		// --- Then ---
+		assert.True(t, have)
+		assert.Same(t, nev, set.TagGet("A"))
+	})
+
+	t.Run("not set when modified", func(t *testing.T) {
+		// --- Given ---
+		cur := tstInt("A", 3)
+		set := NewSyncTagSet()
+		set.TagSet(cur)
+
+		// --- When ---
+		have := set.TagCompareAndSet("A", tstInt("A", 1), tstInt("A", 2))
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.Same(t, cur, set.TagGet("A"))
+	})
+
+	t.Run("not set when kind changed", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncTagSet()
+		set.TagSet(tstStr("A", "1"))
+
+		// --- When ---
+		have := set.TagCompareAndSet("A", tstInt("A", 1), tstInt("A", 2))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("add when not existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncTagSet()
+
+		// --- When ---
+		have := set.TagCompareAndSet("A", nil, tstInt("A", 1))
+
+		// --- Then ---
+		assert.True(t, have)
+		assert.Equal(t, 1, set.TagCount())
+	})
+
+	t.Run("not added when existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncTagSet()
+		set.TagSet(tstInt("A", 1))
+
+		// --- When ---
+		have := set.TagCompareAndSet("A", nil, tstInt("A", 2))
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.Equal(t, 1, set.TagGet("A").TagValue())
+	})
+
+	t.Run("not set when not existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncTagSet()
+
+		// --- When ---
+		have := set.TagCompareAndSet("A", tstInt("A", 1), tstInt("A", 2))
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.Equal(t, 0, set.TagCount())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncTagSet()
+		set.TagSet(tstInt("A", 1))
+
+		// --- When ---
+		have := set.TagCompareAndSet("A", tstInt("A", 1), nil)
+
+		// --- Then ---
+		assert.True(t, have)
+		assert.Equal(t, 0, set.TagCount())
+	})
+
+	t.Run("new tag with other name", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncTagSet()
+
+		// --- When ---
+		have := set.TagCompareAndSet("A", nil, tstInt("B", 1))
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.Equal(t, 0, set.TagCount())
+	})
+}
+
+func Test_SyncTagSet_TagUpdate(t *testing.T) {
+	t.Run("update", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncTagSet()
+		set.TagSet(tstInt("A", 1))
+
+		// --- When ---
+		have := set.TagUpdate("A", func(cur Tag) Tag {
+			return tstInt("A", cur.TagValue().(int)+1)
+		})
+
+		// --- Then ---
+		assert.Equal(t, 2, have.TagValue())
+		assert.Same(t, have, set.TagGet("A"))
+	})
+
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncTagSet()
+		var got Tag = tstInt("X", 0)
+
+		// --- When ---
+		have := set.TagUpdate("A", func(cur Tag) Tag {
+			got = cur
+			return tstInt("A", 1)
+		})
+
+		// --- Then ---
+		assert.Nil(t, got)
+		assert.Same(t, have, set.TagGet("A"))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncTagSet()
+		set.TagSet(tstInt("A", 1))
+
+		// --- When ---
+		have := set.TagUpdate("A", func(Tag) Tag { return nil })
+
+		// --- Then ---
+		assert.Nil(t, have)
+		assert.Equal(t, 0, set.TagCount())
+	})
+
+	t.Run("different name", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncTagSet()
+		tag := tstInt("A", 1)
+		set.TagSet(tag)
+
+		// --- When ---
+		have := set.TagUpdate("A", func(Tag) Tag { return tstInt("B", 2) })
+
+		// --- Then ---
+		assert.Same(t, tag, have)
+		assert.Same(t, tag, set.TagGet("A"))
+		assert.Nil(t, set.TagGet("B"))
+		assert.Equal(t, 1, set.TagCount())
+	})
+
+	t.Run("different name for not existing", func(t *testing.T) {
+		// --- Given ---
+		set := NewSyncTagSet()
+
+		// --- When ---
+		have := set.TagUpdate("A", func(Tag) Tag { return tstInt("B", 2) })
+
+		// --- Then ---
+		assert.Nil(t, have)
+		assert.Equal(t, 0, set.TagCount())
+	})
+}
+
+func Test_SyncTagSet_concurrent(t *testing.T) {
+	// --- Given ---
+	set := NewSyncTagSet()
+	set.TagSet(tstInt("A", 0))
+	wg := sync.WaitGroup{}
+
+	// --- When ---
+	for range 10 {
+		wg.Go(func() {
+			for range 100 {
+				set.TagUpdate("A", func(cur Tag) Tag {
+					return tstInt("A", cur.TagValue().(int)+1)
+				})
+				for range set.TagSnapshot().TagGetAll() {
+					set.TagSet(tstInt("B", 1))
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	// --- Then ---
+	assert.Equal(t, 1000, set.TagGet("A").TagValue())
+}
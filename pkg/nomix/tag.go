@@ -48,6 +48,14 @@ func (tk Kind) String() string {
 	}
 }
 
+// Base returns the base [Kind] the kind is derived from, with the
+// [KindSlice] modifier cleared. For example, the base kind of [KindIntSlice]
+// is [KindInt64].
+func (tk Kind) Base() Kind { return tk & 0b00000000_01111111 }
+
+// IsSlice returns true if the kind has the [KindSlice] modifier set.
+func (tk Kind) IsSlice() bool { return tk&KindSlice != 0 }
+
 // Base [Tag] kinds.
 const (
 	KindString  Kind = 0b00000000_00000010
@@ -122,6 +130,12 @@ type AllGetter interface {
 	TagGetAll() map[string]Tag
 }
 
+// TagManager is an interface for managing all tags in the set.
+type TagManager interface {
+	Tagger
+	AllGetter
+}
+
 // Comparer is an interface for comparing tags.
 type Comparer interface {
 	// TagSame returns true if both tags have the same name, kind and value.
@@ -134,6 +148,13 @@ type ValueComparer interface {
 	TagEqual(other Tag) bool
 }
 
+// Cloner is an interface for copying tags.
+type Cloner interface {
+	// TagClone returns a deep copy of the tag. Modifying the copy must not
+	// change the original tag.
+	TagClone() Tag
+}
+
 // Creator is an interface for creating [Tag] instances.
 type Creator interface {
 	// TagCreate creates the appropriate [Tag] instance based on the value's
@@ -3,6 +3,13 @@
 
 package nomix
 
+import (
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+)
+
 // TagSet represents a set of tags.
 type TagSet struct {
 	m map[string]Tag
@@ -67,3 +74,52 @@ func (set TagSet) MetaGetAll() map[string]any {
 	}
 	return m
 }
+
+// MetaAppend appends values of all tags in the set to the map.
+func (set TagSet) MetaAppend(m map[string]any) {
+	for name, tag := range set.m {
+		m[name] = tag.TagValue()
+	}
+}
+
+// TagAll returns an iterator over all tags in the set. The iteration order is
+// not specified.
+func (set TagSet) TagAll() iter.Seq2[string, Tag] {
+	return maps.All(set.m)
+}
+
+// TagSorted returns an iterator over all tags in the set sorted by name.
+func (set TagSet) TagSorted() iter.Seq2[string, Tag] {
+	return set.TagByPrefix("")
+}
+
+// TagByKind returns an iterator over tags of the given kind sorted by name.
+func (set TagSet) TagByKind(knd Kind) iter.Seq2[string, Tag] {
+	return set.tagFilter(func(_ string, tag Tag) bool {
+		return tag.TagKind() == knd
+	})
+}
+
+// TagByPrefix returns an iterator over tags with names starting with the
+// given prefix sorted by name.
+func (set TagSet) TagByPrefix(prefix string) iter.Seq2[string, Tag] {
+	return set.tagFilter(func(name string, _ Tag) bool {
+		return strings.HasPrefix(name, prefix)
+	})
+}
+
+// tagFilter returns an iterator over tags matching the function sorted by
+// name.
+func (set TagSet) tagFilter(fn func(string, Tag) bool) iter.Seq2[string, Tag] {
+	return func(yield func(string, Tag) bool) {
+		for _, name := range slices.Sorted(maps.Keys(set.m)) {
+			tag := set.m[name]
+			if tag == nil || !fn(name, tag) {
+				continue
+			}
+			if !yield(name, tag) {
+				return
+			}
+		}
+	}
+}
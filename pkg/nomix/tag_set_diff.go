@@ -0,0 +1,295 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// Compile time checks.
+var (
+	_ json.Marshaler   = Patch{}
+	_ json.Unmarshaler = (*Patch)(nil)
+)
+
+// ChangeOp represents the type of the tag change.
+type ChangeOp uint8
+
+// Tag change types.
+const (
+	ChangeAdd    ChangeOp = iota + 1 // Tag was added.
+	ChangeRemove                     // Tag was removed.
+	ChangeUpdate                     // Tag value, or kind, was changed.
+)
+
+// String implements [fmt.Stringer].
+func (op ChangeOp) String() string {
+	switch op {
+	case ChangeAdd:
+		return "add"
+	case ChangeRemove:
+		return "remove"
+	case ChangeUpdate:
+		return "update"
+	default:
+		return "unknown"
+	}
+}
+
+// Change represents a change of a single tag.
+type Change struct {
+	Op   ChangeOp // Change type.
+	Name string   // Tag name.
+	Old  Tag      // Tag before the change, nil for added tags.
+	New  Tag      // Tag after the change, nil for removed tags.
+}
+
+// KindChanged returns true if the change modifies the tag kind.
+func (ch Change) KindChanged() bool {
+	if ch.Old == nil || ch.New == nil {
+		return false
+	}
+	return ch.Old.TagKind() != ch.New.TagKind()
+}
+
+// Patch represents a list of tag changes sorted by tag name.
+type Patch []Change
+
+// Diff returns the patch with changes transforming the "from" tags into the
+// "to" tags. Tags are compared using their kinds and the
+// [ValueComparer.TagEqual] or, when not implemented, the [Comparer.TagSame]
+// methods. Tags implementing neither are compared by their values.
+func Diff(from, to AllGetter) Patch {
+	src, dst := from.TagGetAll(), to.TagGetAll()
+	names := make([]string, 0, len(src)+len(dst))
+	for name := range src {
+		names = append(names, name)
+	}
+	for name := range dst {
+		if _, ok := src[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var patch Patch
+	for _, name := range names {
+		old, nev := src[name], dst[name]
+		ch := Change{Name: name, Old: old, New: nev}
+		switch {
+		case old == nil:
+			ch.Op = ChangeAdd
+		case nev == nil:
+			ch.Op = ChangeRemove
+		case !tagEqual(old, nev):
+			ch.Op = ChangeUpdate
+		default:
+			continue
+		}
+		patch = append(patch, ch)
+	}
+	return patch
+}
+
+// Apply applies the patch to the tags. With the [WithPrecondition] option,
+// before any change is made, it checks that added tags don't exist, and
+// removed or updated tags are equal to their [Change.Old] values. Use it to
+// detect the tags were modified since the patch was created.
+//
+// Returns an error wrapping [ErrConflict] if the precondition is not met, or
+// [ErrInvValue] if a change is malformed. No changes are applied in both
+// cases.
+func (p Patch) Apply(dst Tagger, opts ...Option) error {
+	def := NewOptions(opts...)
+	for _, ch := range p {
+		if err := ch.check(dst, def.Precondition); err != nil {
+			return err
+		}
+	}
+	for _, ch := range p {
+		if ch.Op == ChangeRemove {
+			dst.TagDelete(ch.Name)
+			continue
+		}
+		dst.TagSet(ch.New)
+	}
+	return nil
+}
+
+// check checks the change is valid, and when precondition is set, it can be
+// applied to the tags.
+func (ch Change) check(dst Tagger, precondition bool) error {
+	switch {
+	case ch.Op == ChangeRemove:
+		if ch.Old == nil && precondition {
+			return fmt.Errorf("%s: %w: missing old tag", ch.Name, ErrInvValue)
+		}
+	case ch.Op == ChangeAdd || ch.Op == ChangeUpdate:
+		if ch.New == nil || ch.New.TagName() != ch.Name {
+			return fmt.Errorf("%s: %w: invalid new tag", ch.Name, ErrInvValue)
+		}
+		if ch.Op == ChangeUpdate && ch.Old == nil && precondition {
+			return fmt.Errorf("%s: %w: missing old tag", ch.Name, ErrInvValue)
+		}
+	default:
+		const format = "%s: %w: change %s(%d)"
+		return fmt.Errorf(format, ch.Name, ErrInvValue, ch.Op, ch.Op)
+	}
+	if !precondition {
+		return nil
+	}
+	cur := dst.TagGet(ch.Name)
+	if ch.Op == ChangeAdd {
+		if cur != nil {
+			return fmt.Errorf("%s: %w: tag exists", ch.Name, ErrConflict)
+		}
+		return nil
+	}
+	if cur == nil {
+		return fmt.Errorf("%s: %w: tag doesn't exist", ch.Name, ErrConflict)
+	}
+	if !tagEqual(cur, ch.Old) {
+		return fmt.Errorf("%s: %w: tag was modified", ch.Name, ErrConflict)
+	}
+	return nil
+}
+
+// changeJSON is the JSON representation of a [Change].
+type changeJSON struct {
+	Op   string   `json:"op"`
+	Name string   `json:"name"`
+	Old  *tagJSON `json:"old,omitempty"`
+	New  *tagJSON `json:"new,omitempty"`
+}
+
+// MarshalJSON implements [json.Marshaler] interface. Changes are encoded as
+// an array of objects with the change type, the tag name, and the old and
+// new tags encoded the same way as in the verbose form of [MarshalTagSet]:
+//
+//	[
+//	  {
+//	    "op": "update",
+//	    "name": "A",
+//	    "old": {"name": "A", "kind": 516, "type": "KindInt", "value": 42},
+//	    "new": {"name": "A", "kind": 516, "type": "KindInt", "value": 44}
+//	  }
+//	]
+//
+// Tags must implement [fmt.Stringer].
+func (p Patch) MarshalJSON() ([]byte, error) {
+	items := make([]changeJSON, 0, len(p))
+	for _, ch := range p {
+		item := changeJSON{Op: ch.Op.String(), Name: ch.Name}
+		var err error
+		if item.Old, err = encodeChangeTag(ch.Old); err != nil {
+			return nil, fmt.Errorf("%s: %w", ch.Name, err)
+		}
+		if item.New, err = encodeChangeTag(ch.New); err != nil {
+			return nil, fmt.Errorf("%s: %w", ch.Name, err)
+		}
+		items = append(items, item)
+	}
+	return json.Marshal(items)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler] interface. It decodes the patch
+// using [UnmarshalPatch] with the [GlobalRegistry], which is empty unless
+// specs are registered in it, for example with:
+//
+//	xtag.RegisterAll(nomix.GlobalRegistry())
+//
+// Use [UnmarshalPatch] to decode the patch with other registry.
+func (p *Patch) UnmarshalJSON(data []byte) error {
+	patch, err := UnmarshalPatch(GlobalRegistry(), data)
+	if err != nil {
+		return err
+	}
+	*p = patch
+	return nil
+}
+
+// UnmarshalPatch decodes the JSON document created by [Patch.MarshalJSON].
+// Tags are created using the [KindSpec] registered in the [Registry] for the
+// encoded tag [Kind]. The registry default options, followed by the given
+// options, are passed to [KindSpec.TagParse].
+func UnmarshalPatch(reg *Registry, data []byte, opts ...Option) (Patch, error) {
+	var items []changeJSON
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	patch := make(Patch, 0, len(items))
+	for _, item := range items {
+		ch := Change{Name: item.Name}
+		switch item.Op {
+		case "add":
+			ch.Op = ChangeAdd
+		case "remove":
+			ch.Op = ChangeRemove
+		case "update":
+			ch.Op = ChangeUpdate
+		default:
+			const format = "%s: %w: change %q"
+			return nil, fmt.Errorf(format, item.Name, ErrInvFormat, item.Op)
+		}
+		var err error
+		if ch.Old, err = decodeChangeTag(reg, item.Old, opts...); err != nil {
+			return nil, err
+		}
+		if ch.New, err = decodeChangeTag(reg, item.New, opts...); err != nil {
+			return nil, err
+		}
+		patch = append(patch, ch)
+	}
+	return patch, nil
+}
+
+// encodeChangeTag returns the verbose JSON representation of the tag, or nil
+// if the tag is nil.
+func encodeChangeTag(tag Tag) (*tagJSON, error) {
+	if tag == nil {
+		return nil, nil
+	}
+	val, err := encodeTagValue(tag)
+	if err != nil {
+		return nil, err
+	}
+	item := &tagJSON{
+		Name:  tag.TagName(),
+		Kind:  tag.TagKind(),
+		Type:  tag.TagKind().String(),
+		Value: val,
+	}
+	return item, nil
+}
+
+// decodeChangeTag creates a [Tag] from its verbose JSON representation.
+// Returns nil if the item is nil.
+func decodeChangeTag(
+	reg *Registry,
+	item *tagJSON,
+	opts ...Option,
+) (Tag, error) {
+
+	if item == nil {
+		return nil, nil
+	}
+	return decodeTag(reg, *item, opts...)
+}
+
+// tagEqual returns true if both tags have the same kind and value.
+func tagEqual(a, b Tag) bool {
+	if a.TagKind() != b.TagKind() {
+		return false
+	}
+	if cmp, ok := a.(ValueComparer); ok {
+		return cmp.TagEqual(b)
+	}
+	if cmp, ok := a.(Comparer); ok {
+		return cmp.TagSame(b)
+	}
+	return reflect.DeepEqual(a.TagValue(), b.TagValue())
+}
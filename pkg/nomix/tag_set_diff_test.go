@@ -0,0 +1,520 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// tstInt returns the [KindInt] tag used in diff tests.
+func tstInt(name string, val int) Tag {
+	return NewSingle(name, val, KindInt, strconv.Itoa, nil, nil)
+}
+
+// tstStr returns the [KindString] tag used in diff tests.
+func tstStr(name, val string) Tag {
+	return NewSingle(name, val, KindString, tstStrValue, nil, nil)
+}
+
+// tstSet returns a [TagSet] with the given tags.
+func tstSet(tags ...Tag) TagSet {
+	set := NewTagSet()
+	set.TagSet(tags...)
+	return set
+}
+
+// tstPlainTag is a [Tag] implementing neither [Comparer] nor
+// [ValueComparer].
+type tstPlainTag struct {
+	name  string
+	value []int
+}
+
+func (tag tstPlainTag) TagName() string { return tag.name }
+func (tag tstPlainTag) TagKind() Kind   { return KindIntSlice }
+func (tag tstPlainTag) TagValue() any   { return tag.value }
+
+func Test_ChangeOp_String_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		op  ChangeOp
+		exp string
+	}{
+		{"add", ChangeAdd, "add"},
+		{"remove", ChangeRemove, "remove"},
+		{"update", ChangeUpdate, "update"},
+		{"unknown", ChangeOp(0), "unknown"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.op.String()
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_Change_KindChanged(t *testing.T) {
+	t.Run("changed", func(t *testing.T) {
+		// --- Given ---
+		ch := Change{
+			Op:  ChangeUpdate,
+			Old: tstInt("A", 1),
+			New: tstStr("A", "1"),
+		}
+
+		// --- When ---
+		have := ch.KindChanged()
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not changed", func(t *testing.T) {
+		// --- Given ---
+		ch := Change{Op: ChangeUpdate, Old: tstInt("A", 1), New: tstInt("A", 2)}
+
+		// --- When ---
+		have := ch.KindChanged()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("added", func(t *testing.T) {
+		// --- Given ---
+		ch := Change{Op: ChangeAdd, New: tstInt("A", 2)}
+
+		// --- When ---
+		have := ch.KindChanged()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Diff(t *testing.T) {
+	t.Run("changes", func(t *testing.T) {
+		// --- Given ---
+		from := tstSet(
+			tstInt("A", 1),
+			tstInt("B", 2),
+			tstInt("C", 3),
+			tstInt("D", 4),
+		)
+		to := tstSet(
+			tstInt("A", 1),
+			tstInt("B", 20),
+			tstStr("C", "3"),
+			tstInt("E", 5),
+		)
+
+		// --- When ---
+		have := Diff(from, to)
+
+		// --- Then ---
+		exp := Patch{
+			{
+				Op:   ChangeUpdate,
+				Name: "B",
+				Old:  from.TagGet("B"),
+				New:  to.TagGet("B"),
+			},
+			{
+				Op:   ChangeUpdate,
+				Name: "C",
+				Old:  from.TagGet("C"),
+				New:  to.TagGet("C"),
+			},
+			{Op: ChangeRemove, Name: "D", Old: from.TagGet("D")},
+			{Op: ChangeAdd, Name: "E", New: to.TagGet("E")},
+		}
+		assert.Equal(t, exp, have)
+		assert.False(t, have[0].KindChanged())
+		assert.True(t, have[1].KindChanged())
+	})
+
+	t.Run("equal sets", func(t *testing.T) {
+		// --- Given ---
+		from := tstSet(tstInt("A", 1), tstStr("B", "b"))
+		to := tstSet(tstInt("A", 1), tstStr("B", "b"))
+
+		// --- When ---
+		have := Diff(from, to)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("tags without comparers", func(t *testing.T) {
+		// --- Given ---
+		from := tstSet(
+			tstPlainTag{name: "A", value: []int{1}},
+			tstPlainTag{name: "B", value: []int{2}},
+		)
+		to := tstSet(
+			tstPlainTag{name: "A", value: []int{1}},
+			tstPlainTag{name: "B", value: []int{3}},
+		)
+
+		// --- When ---
+		have := Diff(from, to)
+
+		// --- Then ---
+		assert.Len(t, 1, have)
+		assert.Equal(t, "B", have[0].Name)
+	})
+}
+
+func Test_Patch_Apply(t *testing.T) {
+	t.Run("apply", func(t *testing.T) {
+		// --- Given ---
+		from := tstSet(tstInt("A", 1), tstInt("B", 2), tstInt("C", 3))
+		to := tstSet(tstInt("A", 1), tstStr("B", "b"), tstInt("D", 4))
+		patch := Diff(from, to)
+		dst := tstSet(tstInt("A", 1), tstInt("B", 2), tstInt("C", 3))
+
+		// --- When ---
+		err := patch.Apply(dst)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, Diff(dst, to))
+	})
+
+	t.Run("without precondition overwrites modified", func(t *testing.T) {
+		// --- Given ---
+		patch := Diff(tstSet(tstInt("A", 1)), tstSet(tstInt("A", 2)))
+		dst := tstSet(tstInt("A", 5))
+
+		// --- When ---
+		err := patch.Apply(dst)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 2, dst.TagGet("A").TagValue())
+	})
+
+	t.Run("with precondition", func(t *testing.T) {
+		// --- Given ---
+		from := tstSet(tstInt("A", 1), tstInt("B", 2))
+		to := tstSet(tstInt("A", 2), tstInt("C", 3))
+		patch := Diff(from, to)
+		dst := tstSet(tstInt("A", 1), tstInt("B", 2))
+
+		// --- When ---
+		err := patch.Apply(dst, WithPrecondition)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, Diff(dst, to))
+	})
+
+	t.Run("empty patch", func(t *testing.T) {
+		// --- Given ---
+		dst := tstSet(tstInt("A", 1))
+
+		// --- When ---
+		err := Patch(nil).Apply(dst, WithPrecondition)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 1, dst.TagCount())
+	})
+}
+
+func Test_Patch_Apply_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		patch Patch
+		opts  []Option
+		err   error
+		exp   string
+	}{
+		{
+			"added tag exists",
+			Patch{{Op: ChangeAdd, Name: "A", New: tstInt("A", 2)}},
+			[]Option{WithPrecondition},
+			ErrConflict,
+			"A: conflict: tag exists",
+		},
+		{
+			"removed tag doesn't exist",
+			Patch{{Op: ChangeRemove, Name: "X", Old: tstInt("X", 1)}},
+			[]Option{WithPrecondition},
+			ErrConflict,
+			"X: conflict: tag doesn't exist",
+		},
+		{
+			"updated tag modified",
+			Patch{{
+				Op:   ChangeUpdate,
+				Name: "A",
+				Old:  tstInt("A", 2),
+				New:  tstInt("A", 3),
+			}},
+			[]Option{WithPrecondition},
+			ErrConflict,
+			"A: conflict: tag was modified",
+		},
+		{
+			"updated tag kind changed",
+			Patch{{
+				Op:   ChangeUpdate,
+				Name: "A",
+				Old:  tstStr("A", "1"),
+				New:  tstInt("A", 3),
+			}},
+			[]Option{WithPrecondition},
+			ErrConflict,
+			"A: conflict: tag was modified",
+		},
+		{
+			"removed without old tag",
+			Patch{{Op: ChangeRemove, Name: "A"}},
+			[]Option{WithPrecondition},
+			ErrInvValue,
+			"A: invalid element value: missing old tag",
+		},
+		{
+			"updated without old tag",
+			Patch{{Op: ChangeUpdate, Name: "A", New: tstInt("A", 3)}},
+			[]Option{WithPrecondition},
+			ErrInvValue,
+			"A: invalid element value: missing old tag",
+		},
+		{
+			"added without new tag",
+			Patch{{Op: ChangeAdd, Name: "X"}},
+			nil,
+			ErrInvValue,
+			"X: invalid element value: invalid new tag",
+		},
+		{
+			"new tag with other name",
+			Patch{{Op: ChangeAdd, Name: "X", New: tstInt("Y", 1)}},
+			nil,
+			ErrInvValue,
+			"X: invalid element value: invalid new tag",
+		},
+		{
+			"unknown change",
+			Patch{{Name: "A"}},
+			nil,
+			ErrInvValue,
+			"A: invalid element value: change unknown(0)",
+		},
+		{
+			"nothing applied when last fails",
+			Patch{
+				{Op: ChangeRemove, Name: "A", Old: tstInt("A", 1)},
+				{Op: ChangeAdd, Name: "B", New: tstInt("B", 1)},
+			},
+			[]Option{WithPrecondition},
+			ErrConflict,
+			"B: conflict: tag exists",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			dst := tstSet(tstInt("A", 1), tstInt("B", 2))
+
+			// --- When ---
+			err := tc.patch.Apply(dst, tc.opts...)
+
+			// --- Then ---
+			assert.ErrorIs(t, tc.err, err)
+			assert.ErrorEqual(t, tc.exp, err)
+			assert.Nil(t, Diff(dst, tstSet(tstInt("A", 1), tstInt("B", 2))))
+		})
+	}
+}
+
+func Test_Patch_MarshalJSON(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		// --- Given ---
+		from := tstSet(tstInt("A", 1), tstInt("B", 2))
+		to := tstSet(tstStr("A", "a"), tstInt("C", 3))
+		patch := Diff(from, to)
+
+		// --- When ---
+		have, err := json.Marshal(patch)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := `[
+			{
+				"op": "update",
+				"name": "A",
+				"old": {
+					"name": "A", "kind": 516, "type": "KindInt", "value": 1
+				},
+				"new": {
+					"name": "A", "kind": 2, "type": "KindString", "value": "a"
+				}
+			},
+			{
+				"op": "remove",
+				"name": "B",
+				"old": {"name": "B", "kind": 516, "type": "KindInt", "value": 2}
+			},
+			{
+				"op": "add",
+				"name": "C",
+				"new": {"name": "C", "kind": 516, "type": "KindInt", "value": 3}
+			}
+		]`
+		assert.JSON(t, exp, string(have))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- When ---
+		have, err := json.Marshal(Patch{})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "[]", string(have))
+	})
+
+	t.Run("error - old tag not stringer", func(t *testing.T) {
+		// --- Given ---
+		patch := Patch{{Op: ChangeRemove, Name: "A", Old: tstPlainTag{}}}
+
+		// --- When ---
+		have, err := json.Marshal(patch)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotImpl, err)
+		assert.ErrorContain(t, "A: ", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - new tag not stringer", func(t *testing.T) {
+		// --- Given ---
+		patch := Patch{{Op: ChangeAdd, Name: "A", New: tstPlainTag{}}}
+
+		// --- When ---
+		have, err := json.Marshal(patch)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotImpl, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_UnmarshalPatch(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		from := tstSet(tstInt("A", 1), tstInt("B", 2))
+		to := tstSet(tstStr("A", "a"), tstInt("C", 3))
+		data, err := json.Marshal(Diff(from, to))
+		assert.NoError(t, err)
+
+		// --- When ---
+		have, err := UnmarshalPatch(TstRegistry(), data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 3, have)
+		dst := tstSet(tstInt("A", 1), tstInt("B", 2))
+		assert.NoError(t, have.Apply(dst, WithPrecondition))
+		assert.Nil(t, Diff(dst, to))
+	})
+
+	t.Run("error - invalid JSON", func(t *testing.T) {
+		// --- When ---
+		have, err := UnmarshalPatch(TstRegistry(), []byte(`{`))
+
+		// --- Then ---
+		assert.Error(t, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - unknown change", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`[{"op": "abc", "name": "A"}]`)
+
+		// --- When ---
+		have, err := UnmarshalPatch(TstRegistry(), data)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.ErrorEqual(t, `A: invalid element format: change "abc"`, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - old tag kind not registered", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`[{
+			"op": "remove",
+			"name": "A",
+			"old": {"name": "A", "kind": 8, "value": 1.5}
+		}]`)
+
+		// --- When ---
+		have, err := UnmarshalPatch(TstRegistry(), data)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNoSpec, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - new tag kind not registered", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`[{
+			"op": "add",
+			"name": "A",
+			"new": {"name": "A", "kind": 8, "value": 1.5}
+		}]`)
+
+		// --- When ---
+		have, err := UnmarshalPatch(TstRegistry(), data)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNoSpec, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Patch_UnmarshalJSON(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		var patch Patch
+
+		// --- When ---
+		err := json.Unmarshal([]byte(`[]`), &patch)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 0, patch)
+	})
+
+	t.Run("error - kind not registered", func(t *testing.T) {
+		// --- Given ---
+		var patch Patch
+		data := []byte(`[{
+			"op": "add",
+			"name": "A",
+			"new": {"name": "A", "kind": 4, "value": 1}
+		}]`)
+
+		// --- When ---
+		err := json.Unmarshal(data, &patch)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNoSpec, err)
+		assert.Nil(t, patch)
+	})
+}
@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Compile time checks.
+var (
+	_ json.Marshaler   = TagSet{}
+	_ json.Unmarshaler = (*TagSet)(nil)
+)
+
+// tagJSON is the verbose JSON representation of a [Tag].
+type tagJSON struct {
+	Name  string          `json:"name"`
+	Kind  Kind            `json:"kind"`
+	Type  string          `json:"type,omitempty"`
+	Value json.RawMessage `json:"value"`
+}
+
+// MarshalJSON implements [json.Marshaler] interface. It encodes the set using
+// the compact form described in [MarshalTagSet].
+func (set TagSet) MarshalJSON() ([]byte, error) { return MarshalTagSet(set) }
+
+// UnmarshalJSON implements [json.Unmarshaler] interface. It decodes the set
+// using [UnmarshalTagSet] with the [GlobalRegistry], which is empty unless
+// specs are registered in it, for example with:
+//
+//	xtag.RegisterAll(nomix.GlobalRegistry())
+//
+// Use [UnmarshalTagSet] to decode the set with other registry.
+func (set *TagSet) UnmarshalJSON(data []byte) error {
+	tags, err := UnmarshalTagSet(GlobalRegistry(), data)
+	if err != nil {
+		return err
+	}
+	*set = tags
+	return nil
+}
+
+// MarshalTagSet encodes the [TagSet] as a kind-annotated JSON document. By
+// default, the compact form is used, which is an object mapping tag names to
+// two-element arrays with the tag [Kind] and the tag value:
+//
+//	{"A": [516, 42], "B": [2, "abc"]}
+//
+// With the [WithVerbose] option, the verbose form is used, which is an array
+// of objects sorted by tag name:
+//
+//	[
+//	  {"name": "A", "kind": 516, "type": "KindInt", "value": 42},
+//	  {"name": "B", "kind": 2, "type": "KindString", "value": "abc"}
+//	]
+//
+// Tag values are encoded using their string representation; hence all tags
+// in the set must implement [fmt.Stringer]. Use [UnmarshalTagSet] to decode
+// the document.
+func MarshalTagSet(set TagSet, opts ...Option) ([]byte, error) {
+	def := NewOptions(opts...)
+
+	names := make([]string, 0, len(set.m))
+	for name := range set.m {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	if def.Verbose {
+		items := make([]tagJSON, 0, len(names))
+		for _, name := range names {
+			tag := set.m[name]
+			val, err := encodeTagValue(tag)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			item := tagJSON{
+				Name:  name,
+				Kind:  tag.TagKind(),
+				Type:  tag.TagKind().String(),
+				Value: val,
+			}
+			items = append(items, item)
+		}
+		return json.Marshal(items)
+	}
+
+	items := make(map[string][2]any, len(names))
+	for _, name := range names {
+		tag := set.m[name]
+		val, err := encodeTagValue(tag)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		items[name] = [2]any{tag.TagKind(), val}
+	}
+	return json.Marshal(items)
+}
+
+// UnmarshalTagSet decodes the JSON document created by [MarshalTagSet] to a
+// new [TagSet]. Both the compact and the verbose forms are supported. Tags are
+// created using the [KindSpec] registered in the [Registry] for the encoded
+// tag [Kind]. The registry default options, followed by the given options,
+// are passed to [KindSpec.TagParse].
+//
+// Returns an error if the document is not valid, or when any of the tags
+// cannot be created. In the latter case, the error for the first tag, in the
+// tag name order, is returned.
+func UnmarshalTagSet(
+	reg *Registry,
+	data []byte,
+	opts ...Option,
+) (TagSet, error) {
+
+	var items []tagJSON
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		if err := json.Unmarshal(data, &items); err != nil {
+			return TagSet{}, err
+		}
+	} else {
+		var compact map[string][2]json.RawMessage
+		if err := json.Unmarshal(data, &compact); err != nil {
+			return TagSet{}, err
+		}
+		items = make([]tagJSON, 0, len(compact))
+		for name, pair := range compact {
+			item := tagJSON{Name: name, Value: pair[1]}
+			if err := json.Unmarshal(pair[0], &item.Kind); err != nil {
+				return TagSet{}, err
+			}
+			items = append(items, item)
+		}
+	}
+
+	slices.SortFunc(items, func(a, b tagJSON) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	set := NewTagSet(WithLen(len(items)))
+	for _, item := range items {
+		tag, err := decodeTag(reg, item, opts...)
+		if err != nil {
+			return TagSet{}, err
+		}
+		set.TagSet(tag)
+	}
+	return set, nil
+}
+
+// encodeTagValue returns the JSON representation of the tag value. Values of
+// string-like single value kinds, and values which string representation is
+// not a valid JSON, are encoded as JSON strings. Other values are encoded
+// as-is.
+func encodeTagValue(tag Tag) (json.RawMessage, error) {
+	str, ok := tag.(fmt.Stringer)
+	if !ok {
+		const format = "%w: tag must implement fmt.Stringer"
+		return nil, fmt.Errorf(format, ErrNotImpl)
+	}
+	val := str.String()
+	knd := tag.TagKind()
+	if knd.Base() == KindJSON {
+		if !json.Valid([]byte(val)) {
+			return nil, ErrInvFormat
+		}
+		return json.RawMessage(val), nil
+	}
+	if isStringKind(knd) || !json.Valid([]byte(val)) {
+		return json.Marshal(val)
+	}
+	return json.RawMessage(val), nil
+}
+
+// decodeTag creates a [Tag] from its verbose JSON representation.
+func decodeTag(reg *Registry, item tagJSON, opts ...Option) (Tag, error) {
+	spec := reg.SpecForKind(item.Kind)
+	if spec.IsZero() {
+		const format = "%s: %w for %[3]s(%[3]d)"
+		return nil, fmt.Errorf(format, item.Name, ErrNoSpec, item.Kind)
+	}
+	val := string(item.Value)
+	if item.Kind.Base() != KindJSON && len(val) > 0 && val[0] == '"' {
+		if err := json.Unmarshal(item.Value, &val); err != nil {
+			return nil, fmt.Errorf("%s: %w", item.Name, ErrInvFormat)
+		}
+	}
+	return spec.TagParse(item.Name, val, reg.options(opts)...)
+}
+
+// isStringKind returns true for single value kinds based on [KindString],
+// [KindTime] or [KindUUID] base kinds.
+func isStringKind(knd Kind) bool {
+	if knd.IsSlice() {
+		return false
+	}
+	switch knd.Base() {
+	case KindString, KindTime, KindUUID:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,344 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+// tstStrParse is a test parse function for the [KindString] tags.
+func tstStrParse(name, val string, _ ...Option) (Tag, error) {
+	return NewSingle(name, val, KindString, tstStrValue, nil, nil), nil
+}
+
+// tstStrValue returns the string as-is.
+func tstStrValue(v string) string { return v }
+
+// tstJSONTagSet returns the [TagSet] used in JSON encoding tests.
+func tstJSONTagSet() TagSet {
+	set := NewTagSet()
+	set.TagSet(
+		NewSingle("A", 42, KindInt, strconv.Itoa, nil, nil),
+		NewSingle("B", `a"b`, KindString, tstStrValue, nil, nil),
+	)
+	return set
+}
+
+func Test_TagSet_MarshalJSON(t *testing.T) {
+	// --- Given ---
+	set := tstJSONTagSet()
+
+	// --- When ---
+	have, err := json.Marshal(set)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.JSON(t, `{"A": [516, 42], "B": [2, "a\"b"]}`, string(have))
+}
+
+func Test_TagSet_UnmarshalJSON(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		var set TagSet
+
+		// --- When ---
+		err := json.Unmarshal([]byte(`{}`), &set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 0, set.TagCount())
+	})
+
+	t.Run("error - kind not registered", func(t *testing.T) {
+		// --- Given ---
+		var set TagSet
+
+		// --- When ---
+		err := json.Unmarshal([]byte(`{"A": [4, 1]}`), &set)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNoSpec, err)
+		assert.ErrorContain(t, "A: ", err)
+	})
+}
+
+func Test_MarshalTagSet(t *testing.T) {
+	t.Run("compact", func(t *testing.T) {
+		// --- Given ---
+		set := tstJSONTagSet()
+
+		// --- When ---
+		have, err := MarshalTagSet(set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.JSON(t, `{"A": [516, 42], "B": [2, "a\"b"]}`, string(have))
+	})
+
+	t.Run("verbose", func(t *testing.T) {
+		// --- Given ---
+		set := tstJSONTagSet()
+
+		// --- When ---
+		have, err := MarshalTagSet(set, WithVerbose)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `[
+			{"name": "A", "kind": 516, "type": "KindInt", "value": 42},
+			{"name": "B", "kind": 2, "type": "KindString", "value": "a\"b"}
+		]`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("empty set", func(t *testing.T) {
+		// --- When ---
+		have, err := MarshalTagSet(NewTagSet())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{}`, string(have))
+	})
+
+	t.Run("empty set verbose", func(t *testing.T) {
+		// --- When ---
+		have, err := MarshalTagSet(NewTagSet(), WithVerbose)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `[]`, string(have))
+	})
+
+	t.Run("non JSON value is quoted", func(t *testing.T) {
+		// --- Given ---
+		set := NewTagSet()
+		str := func(v int) string { return "v" + strconv.Itoa(v) }
+		set.TagSet(NewSingle("A", 42, KindInt, str, nil, nil))
+
+		// --- When ---
+		have, err := MarshalTagSet(set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.JSON(t, `{"A": [516, "v42"]}`, string(have))
+	})
+
+	t.Run("json kind value is not quoted", func(t *testing.T) {
+		// --- Given ---
+		set := NewTagSet()
+		val := `{"a":1}`
+		set.TagSet(NewSingle("A", val, KindJSON, tstStrValue, nil, nil))
+
+		// --- When ---
+		have, err := MarshalTagSet(set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.JSON(t, `{"A": [32, {"a": 1}]}`, string(have))
+	})
+
+	t.Run("error - invalid json kind value", func(t *testing.T) {
+		// --- Given ---
+		set := NewTagSet()
+		val := `{"a":`
+		set.TagSet(NewSingle("A", val, KindJSON, tstStrValue, nil, nil))
+
+		// --- When ---
+		have, err := MarshalTagSet(set)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.ErrorContain(t, "A: ", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - tag not implementing Stringer", func(t *testing.T) {
+		// --- Given ---
+		set := NewTagSet()
+		set.TagSet(TstTag(t, "A", KindInt, 42))
+
+		// --- When ---
+		have, err := MarshalTagSet(set, WithVerbose)
+
+		// --- Then ---
+		wMsg := "A: not implemented: tag must implement fmt.Stringer"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrNotImpl, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_UnmarshalTagSet(t *testing.T) {
+	t.Run("compact", func(t *testing.T) {
+		// --- Given ---
+		reg := TstRegistry()
+		data := []byte(`{"A": [516, 42], "B": [2, "a\"b"]}`)
+
+		// --- When ---
+		have, err := UnmarshalTagSet(reg, data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 2, have.TagCount())
+		assert.Equal(t, KindInt, have.TagGet("A").TagKind())
+		assert.Equal(t, 42, have.TagGet("A").TagValue())
+		assert.Equal(t, KindString, have.TagGet("B").TagKind())
+		assert.Equal(t, `a"b`, have.TagGet("B").TagValue())
+	})
+
+	t.Run("verbose", func(t *testing.T) {
+		// --- Given ---
+		reg := TstRegistry()
+		data := []byte(` [
+			{"name": "A", "kind": 516, "type": "KindInt", "value": 42},
+			{"name": "B", "kind": 2, "value": "abc"}
+		]`)
+
+		// --- When ---
+		have, err := UnmarshalTagSet(reg, data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 2, have.TagCount())
+		assert.Equal(t, 42, have.TagGet("A").TagValue())
+		assert.Equal(t, "abc", have.TagGet("B").TagValue())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		reg := TstRegistry()
+		set := tstJSONTagSet()
+		data, err := MarshalTagSet(set, WithVerbose)
+		assert.NoError(t, err)
+
+		// --- When ---
+		have, err := UnmarshalTagSet(reg, data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, set.MetaGetAll(), have.MetaGetAll())
+	})
+
+	t.Run("options are passed to the parser", func(t *testing.T) {
+		// --- Given ---
+		reg := TstRegistry()
+		data := []byte(`{"A": [516, "AA"]}`)
+
+		// --- When ---
+		have, err := UnmarshalTagSet(reg, data, WithRadixHEX)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 170, have.TagGet("A").TagValue())
+	})
+
+	t.Run("registry options are passed to the parser", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry(WithRadixHEX)
+		must.Nil(reg.Register(TstIntSpec()))
+		data := []byte(`{"A": [516, "AA"]}`)
+
+		// --- When ---
+		have, err := UnmarshalTagSet(reg, data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 170, have.TagGet("A").TagValue())
+	})
+
+	t.Run("error - invalid document", func(t *testing.T) {
+		// --- Given ---
+		reg := TstRegistry()
+
+		// --- When ---
+		have, err := UnmarshalTagSet(reg, []byte(`{"A":`))
+
+		// --- Then ---
+		assert.Error(t, err)
+		assert.Equal(t, 0, have.TagCount())
+	})
+
+	t.Run("error - invalid verbose document", func(t *testing.T) {
+		// --- Given ---
+		reg := TstRegistry()
+
+		// --- When ---
+		have, err := UnmarshalTagSet(reg, []byte(`[{"name": 1}]`))
+
+		// --- Then ---
+		assert.Error(t, err)
+		assert.Equal(t, 0, have.TagCount())
+	})
+
+	t.Run("error - invalid kind", func(t *testing.T) {
+		// --- Given ---
+		reg := TstRegistry()
+
+		// --- When ---
+		have, err := UnmarshalTagSet(reg, []byte(`{"A": ["int", 1]}`))
+
+		// --- Then ---
+		assert.Error(t, err)
+		assert.Equal(t, 0, have.TagCount())
+	})
+
+	t.Run("error - kind not registered", func(t *testing.T) {
+		// --- Given ---
+		reg := TstRegistry()
+
+		// --- When ---
+		have, err := UnmarshalTagSet(reg, []byte(`{"A": [4, 1]}`))
+
+		// --- Then ---
+		wMsg := "A: spec not found for KindInt64(4)"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrNoSpec, err)
+		assert.Equal(t, 0, have.TagCount())
+	})
+
+	t.Run("error - invalid value", func(t *testing.T) {
+		// --- Given ---
+		reg := TstRegistry()
+		data := []byte(`{"A": [516, "abc"], "B": [516, 1.5]}`)
+
+		// --- When ---
+		have, err := UnmarshalTagSet(reg, data)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "A: invalid element format", err)
+		assert.ErrorIs(t, ErrInvFormat, err)
+		assert.Equal(t, 0, have.TagCount())
+	})
+}
+
+func Test_isStringKind_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		knd Kind
+		exp bool
+	}{
+		{"string", KindString, true},
+		{"time", KindTime, true},
+		{"uuid", KindUUID, true},
+		{"int", KindInt, false},
+		{"json", KindJSON, false},
+		{"string slice", KindStringSlice, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := isStringKind(tc.knd)
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
@@ -0,0 +1,260 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// MergePolicy represents the way tags with the same name are merged.
+type MergePolicy uint8
+
+// Merge policies.
+const (
+	// MergeLastWins keeps the tag from the last set having it.
+	MergeLastWins MergePolicy = iota
+
+	// MergeFirstWins keeps the tag from the first set having it.
+	MergeFirstWins
+
+	// MergeErrorOnConflict fails the merge when tags have different kinds
+	// or values.
+	MergeErrorOnConflict
+
+	// MergeErrorOnKind fails the merge when tags have different kinds,
+	// otherwise the tag from the last set having it is kept.
+	MergeErrorOnKind
+
+	// MergeUnion concatenates values of slice tags skipping duplicated
+	// elements. For single value tags it works the same way as
+	// [MergeLastWins]. Fails the merge when tags have different kinds.
+	MergeUnion
+
+	// MergeConcat concatenates values of slice tags. For single value tags
+	// it works the same way as [MergeLastWins]. Fails the merge when tags
+	// have different kinds.
+	MergeConcat
+)
+
+// String implements [fmt.Stringer].
+func (p MergePolicy) String() string {
+	switch p {
+	case MergeLastWins:
+		return "last-wins"
+	case MergeFirstWins:
+		return "first-wins"
+	case MergeErrorOnConflict:
+		return "error-on-conflict"
+	case MergeErrorOnKind:
+		return "error-on-kind"
+	case MergeUnion:
+		return "union"
+	case MergeConcat:
+		return "concat"
+	default:
+		return "unknown"
+	}
+}
+
+// MergeConflict represents tags with the same name and different kinds or
+// values found when merging tag sets.
+type MergeConflict struct {
+	Name   string      // Tag name.
+	Policy MergePolicy // Policy used to merge the tags.
+	Tags   []Tag       // Conflicting tags in the order of the merged sets.
+	Tag    Tag         // Merged tag.
+}
+
+// Merger merges tag sets using policies selected per tag name or kind.
+type Merger struct {
+	reg   *Registry              // Registry used to create merged tags.
+	def   MergePolicy            // Default policy.
+	names map[string]MergePolicy // Policies by tag name.
+	kinds map[Kind]MergePolicy   // Policies by tag kind.
+}
+
+// NewMerger returns a new instance of [Merger] with the [MergeLastWins]
+// default policy. The registry is used to create tags with concatenated
+// values, when nil the [GlobalRegistry] is used.
+func NewMerger(reg *Registry) *Merger {
+	if reg == nil {
+		reg = GlobalRegistry()
+	}
+	return &Merger{
+		reg:   reg,
+		names: make(map[string]MergePolicy),
+		kinds: make(map[Kind]MergePolicy),
+	}
+}
+
+// Default sets the policy used for tags without a name or kind policy.
+func (mrg *Merger) Default(p MergePolicy) *Merger {
+	mrg.def = p
+	return mrg
+}
+
+// NamePolicy sets the policy for tags with the given name. Name policies take
+// precedence over kind policies.
+func (mrg *Merger) NamePolicy(name string, p MergePolicy) *Merger {
+	mrg.names[name] = p
+	return mrg
+}
+
+// KindPolicy sets the policy for tags of the given kind. The kind of the tag
+// in the first set having it is used to select the policy.
+func (mrg *Merger) KindPolicy(knd Kind, p MergePolicy) *Merger {
+	mrg.kinds[knd] = p
+	return mrg
+}
+
+// Merge merges the tag sets into a new [TagSet]. Tags with the same name are
+// merged using the selected policies. Returns the merged set and conflicts
+// sorted by tag name. Tags with the same kind and value are not conflicting.
+//
+// Returns an error wrapping [ErrConflict] when the policy doesn't allow the
+// conflict, or other errors when tags with concatenated values cannot be
+// created.
+func (mrg *Merger) Merge(sets ...AllGetter) (TagSet, []MergeConflict, error) {
+	byName := make(map[string][]Tag)
+	for _, set := range sets {
+		for name, tag := range set.TagGetAll() {
+			if tag != nil {
+				byName[name] = append(byName[name], tag)
+			}
+		}
+	}
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	dst := NewTagSet(WithLen(len(names)))
+	var conflicts []MergeConflict
+	for _, name := range names {
+		tags := byName[name]
+		if len(tags) == 1 {
+			dst.TagSet(tags[0])
+			continue
+		}
+		policy := mrg.policy(name, tags[0].TagKind())
+		tag, err := mrg.merge(name, policy, tags)
+		if err != nil {
+			return TagSet{}, nil, err
+		}
+		dst.TagSet(tag)
+		if !allEqual(tags) {
+			cnf := MergeConflict{
+				Name:   name,
+				Policy: policy,
+				Tags:   tags,
+				Tag:    tag,
+			}
+			conflicts = append(conflicts, cnf)
+		}
+	}
+	return dst, conflicts, nil
+}
+
+// policy returns the policy for the tag with the given name and kind.
+func (mrg *Merger) policy(name string, knd Kind) MergePolicy {
+	if p, ok := mrg.names[name]; ok {
+		return p
+	}
+	if p, ok := mrg.kinds[knd]; ok {
+		return p
+	}
+	return mrg.def
+}
+
+// merge merges tags with the same name using the policy.
+func (mrg *Merger) merge(name string, p MergePolicy, tags []Tag) (Tag, error) {
+	first, last := tags[0], tags[len(tags)-1]
+	switch p {
+	case MergeLastWins:
+		return last, nil
+
+	case MergeFirstWins:
+		return first, nil
+
+	case MergeErrorOnConflict:
+		if !allEqual(tags) {
+			return nil, fmt.Errorf("%s: %w: tags differ", name, ErrConflict)
+		}
+		return last, nil
+
+	case MergeErrorOnKind, MergeUnion, MergeConcat:
+		knd := first.TagKind()
+		for _, tag := range tags[1:] {
+			if other := tag.TagKind(); other != knd {
+				const format = "%s: %w: kind %s and %s"
+				return nil, fmt.Errorf(format, name, ErrConflict, knd, other)
+			}
+		}
+		if p == MergeErrorOnKind || !knd.IsSlice() {
+			return last, nil
+		}
+		return mrg.concat(name, tags, p == MergeUnion)
+
+	default:
+		const format = "%s: %w: merge policy %s(%d)"
+		return nil, fmt.Errorf(format, name, ErrInvValue, p, p)
+	}
+}
+
+// concat returns a new tag with concatenated values of the slice tags. All
+// the tags must be of the same kind. When dedup is set, duplicated elements
+// are skipped.
+func (mrg *Merger) concat(name string, tags []Tag, dedup bool) (Tag, error) {
+	knd := tags[0].TagKind()
+	spec := mrg.reg.SpecForKind(knd)
+	if spec.IsZero() {
+		const format = "%s: %w for %[3]s(%[3]d)"
+		return nil, fmt.Errorf(format, name, ErrNoSpec, knd)
+	}
+
+	var val reflect.Value
+	var seen map[any]struct{}
+	for _, tag := range tags {
+		rv := reflect.ValueOf(tag.TagValue())
+		valid := rv.Kind() == reflect.Slice
+		if valid && val.IsValid() {
+			valid = rv.Type() == val.Type()
+		}
+		if !valid {
+			const format = "%s: %w: %T value"
+			return nil, fmt.Errorf(format, name, ErrInvType, tag.TagValue())
+		}
+		if !val.IsValid() {
+			val = reflect.MakeSlice(rv.Type(), 0, rv.Len())
+			if dedup && rv.Type().Elem().Comparable() {
+				seen = make(map[any]struct{}, rv.Len())
+			}
+		}
+		for i := range rv.Len() {
+			elem := rv.Index(i)
+			if seen != nil {
+				key := elem.Interface()
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+			}
+			val = reflect.Append(val, elem)
+		}
+	}
+	return spec.TagCreate(name, val.Interface(), mrg.reg.options(nil)...)
+}
+
+// allEqual returns true if all the tags have the same kind and value.
+func allEqual(tags []Tag) bool {
+	for _, tag := range tags[1:] {
+		if !tagEqual(tags[0], tag) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,353 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomix
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// tstInts returns the [KindIntSlice] tag used in merge tests.
+func tstInts(name string, val ...int) Tag {
+	return NewSlice(name, val, KindIntSlice, nil, nil, nil)
+}
+
+// tstStrs returns the [KindStringSlice] tag used in merge tests.
+func tstStrs(name string, val ...string) Tag {
+	return NewSlice(name, val, KindStringSlice, nil, nil, nil)
+}
+
+// tstMergeRegistry returns the registry with the [KindIntSlice] spec.
+func tstMergeRegistry() *Registry {
+	create := func(name string, val any, _ ...Option) (Tag, error) {
+		if v, ok := val.([]int); ok {
+			return tstInts(name, v...), nil
+		}
+		return nil, ErrInvType
+	}
+	reg := NewRegistry()
+	_ = reg.Register(NewKindSpec(KindIntSlice, create, nil))
+	return reg
+}
+
+func Test_MergePolicy_String_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		p   MergePolicy
+		exp string
+	}{
+		{"last wins", MergeLastWins, "last-wins"},
+		{"first wins", MergeFirstWins, "first-wins"},
+		{"error on conflict", MergeErrorOnConflict, "error-on-conflict"},
+		{"error on kind", MergeErrorOnKind, "error-on-kind"},
+		{"union", MergeUnion, "union"},
+		{"concat", MergeConcat, "concat"},
+		{"unknown", MergePolicy(100), "unknown"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.p.String()
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_NewMerger(t *testing.T) {
+	t.Run("registry", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+
+		// --- When ---
+		have := NewMerger(reg)
+
+		// --- Then ---
+		assert.Same(t, reg, have.reg)
+		assert.Equal(t, MergeLastWins, have.def)
+		assert.Len(t, 0, have.names)
+		assert.Len(t, 0, have.kinds)
+	})
+
+	t.Run("nil registry", func(t *testing.T) {
+		// --- When ---
+		have := NewMerger(nil)
+
+		// --- Then ---
+		assert.Same(t, GlobalRegistry(), have.reg)
+	})
+}
+
+func Test_Merger_Merge(t *testing.T) {
+	t.Run("last wins by default", func(t *testing.T) {
+		// --- Given ---
+		defaults := tstSet(tstInt("A", 1), tstInt("B", 2))
+		overrides := tstSet(tstInt("A", 10), tstInt("C", 3))
+
+		// --- When ---
+		have, conflicts, err := NewMerger(nil).Merge(defaults, overrides)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := tstSet(tstInt("A", 10), tstInt("B", 2), tstInt("C", 3))
+		assert.Nil(t, Diff(exp, have))
+		exp0 := MergeConflict{
+			Name:   "A",
+			Policy: MergeLastWins,
+			Tags:   []Tag{defaults.TagGet("A"), overrides.TagGet("A")},
+			Tag:    overrides.TagGet("A"),
+		}
+		assert.Equal(t, []MergeConflict{exp0}, conflicts)
+	})
+
+	t.Run("does not modify merged sets", func(t *testing.T) {
+		// --- Given ---
+		set0 := tstSet(tstInt("A", 1))
+		set1 := tstSet(tstInt("B", 2))
+
+		// --- When ---
+		have, _, err := NewMerger(nil).Merge(set0, set1)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 2, have.TagCount())
+		assert.Equal(t, 1, set0.TagCount())
+		assert.Equal(t, 1, set1.TagCount())
+	})
+
+	t.Run("equal tags are not conflicting", func(t *testing.T) {
+		// --- Given ---
+		set0 := tstSet(tstInt("A", 1))
+		set1 := tstSet(tstInt("A", 1))
+		mrg := NewMerger(nil).Default(MergeErrorOnConflict)
+
+		// --- When ---
+		have, conflicts, err := mrg.Merge(set0, set1)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Same(t, set1.TagGet("A"), have.TagGet("A"))
+		assert.Nil(t, conflicts)
+	})
+
+	t.Run("first wins", func(t *testing.T) {
+		// --- Given ---
+		set0 := tstSet(tstInt("A", 1))
+		set1 := tstSet(tstStr("A", "a"))
+		set2 := tstSet(tstInt("A", 3))
+		mrg := NewMerger(nil).Default(MergeFirstWins)
+
+		// --- When ---
+		have, conflicts, err := mrg.Merge(set0, set1, set2)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Same(t, set0.TagGet("A"), have.TagGet("A"))
+		assert.Len(t, 1, conflicts)
+		assert.Len(t, 3, conflicts[0].Tags)
+		assert.Equal(t, MergeFirstWins, conflicts[0].Policy)
+	})
+
+	t.Run("error on kind with the same kinds", func(t *testing.T) {
+		// --- Given ---
+		set0 := tstSet(tstInt("A", 1))
+		set1 := tstSet(tstInt("A", 2))
+		mrg := NewMerger(nil).Default(MergeErrorOnKind)
+
+		// --- When ---
+		have, conflicts, err := mrg.Merge(set0, set1)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Same(t, set1.TagGet("A"), have.TagGet("A"))
+		assert.Len(t, 1, conflicts)
+	})
+
+	t.Run("union", func(t *testing.T) {
+		// --- Given ---
+		set0 := tstSet(tstInts("A", 1, 2, 2), tstInt("B", 1))
+		set1 := tstSet(tstInts("A", 3, 1), tstInt("B", 2))
+		mrg := NewMerger(tstMergeRegistry()).Default(MergeUnion)
+
+		// --- When ---
+		have, conflicts, err := mrg.Merge(set0, set1)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, have.TagGet("A").TagValue())
+		assert.Same(t, set1.TagGet("B"), have.TagGet("B"))
+		assert.Len(t, 2, conflicts)
+		assert.Same(t, have.TagGet("A"), conflicts[0].Tag)
+	})
+
+	t.Run("concat", func(t *testing.T) {
+		// --- Given ---
+		set0 := tstSet(tstInts("A", 1, 2))
+		set1 := tstSet(tstInts("A"))
+		set2 := tstSet(tstInts("A", 2, 1))
+		mrg := NewMerger(tstMergeRegistry()).Default(MergeConcat)
+
+		// --- When ---
+		have, conflicts, err := mrg.Merge(set0, set1, set2)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 2, 1}, have.TagGet("A").TagValue())
+		assert.Len(t, 1, conflicts)
+	})
+
+	t.Run("concat equal tags", func(t *testing.T) {
+		// --- Given ---
+		set0 := tstSet(tstInts("A", 1))
+		set1 := tstSet(tstInts("A", 1))
+		mrg := NewMerger(tstMergeRegistry()).Default(MergeConcat)
+
+		// --- When ---
+		have, conflicts, err := mrg.Merge(set0, set1)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 1}, have.TagGet("A").TagValue())
+		assert.Nil(t, conflicts)
+	})
+
+	t.Run("name policy before kind policy", func(t *testing.T) {
+		// --- Given ---
+		set0 := tstSet(tstInt("A", 1), tstInt("B", 1), tstStr("C", "a"))
+		set1 := tstSet(tstInt("A", 2), tstInt("B", 2), tstStr("C", "b"))
+		mrg := NewMerger(nil).
+			KindPolicy(KindInt, MergeFirstWins).
+			NamePolicy("B", MergeLastWins)
+
+		// --- When ---
+		have, _, err := mrg.Merge(set0, set1)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Same(t, set0.TagGet("A"), have.TagGet("A"))
+		assert.Same(t, set1.TagGet("B"), have.TagGet("B"))
+		assert.Same(t, set1.TagGet("C"), have.TagGet("C"))
+	})
+
+	t.Run("kind policy uses the first tag kind", func(t *testing.T) {
+		// --- Given ---
+		set0 := tstSet(tstStr("A", "a"))
+		set1 := tstSet(tstInt("A", 1))
+		mrg := NewMerger(nil).KindPolicy(KindString, MergeFirstWins)
+
+		// --- When ---
+		have, _, err := mrg.Merge(set0, set1)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Same(t, set0.TagGet("A"), have.TagGet("A"))
+	})
+
+	t.Run("no sets", func(t *testing.T) {
+		// --- When ---
+		have, conflicts, err := NewMerger(nil).Merge()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 0, have.TagCount())
+		assert.Nil(t, conflicts)
+	})
+}
+
+func Test_Merger_Merge_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		policy MergePolicy
+		set0   TagSet
+		set1   TagSet
+		err    error
+		exp    string
+	}{
+		{
+			"error on conflict",
+			MergeErrorOnConflict,
+			tstSet(tstInt("A", 1)),
+			tstSet(tstInt("A", 2)),
+			ErrConflict,
+			"A: conflict: tags differ",
+		},
+		{
+			"error on kind",
+			MergeErrorOnKind,
+			tstSet(tstInt("A", 1)),
+			tstSet(tstStr("A", "1")),
+			ErrConflict,
+			"A: conflict: kind KindInt and KindString",
+		},
+		{
+			"union kind mismatch",
+			MergeUnion,
+			tstSet(tstInts("A", 1)),
+			tstSet(tstInt("A", 1)),
+			ErrConflict,
+			"A: conflict: kind KindIntSlice and KindInt",
+		},
+		{
+			"concat kind mismatch",
+			MergeConcat,
+			tstSet(tstInts("A", 1)),
+			tstSet(tstInt("A", 1)),
+			ErrConflict,
+			"A: conflict: kind KindIntSlice and KindInt",
+		},
+		{
+			"concat no spec",
+			MergeConcat,
+			tstSet(tstStrs("A", "a")),
+			tstSet(tstStrs("A", "b")),
+			ErrNoSpec,
+			"A: spec not found for KindStringSlice(130)",
+		},
+		{
+			"concat value not slice",
+			MergeConcat,
+			tstSet(tstInts("A", 1)),
+			tstSet(NewSingle("A", 1, KindIntSlice, nil, nil, nil)),
+			ErrInvType,
+			"A: invalid element type: int value",
+		},
+		{
+			"concat different value types",
+			MergeConcat,
+			tstSet(tstInts("A", 1)),
+			tstSet(NewSlice("A", []int64{1}, KindIntSlice, nil, nil, nil)),
+			ErrInvType,
+			"A: invalid element type: []int64 value",
+		},
+		{
+			"unknown policy",
+			MergePolicy(100),
+			tstSet(tstInt("A", 1)),
+			tstSet(tstInt("A", 1)),
+			ErrInvValue,
+			"A: invalid element value: merge policy unknown(100)",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			mrg := NewMerger(tstMergeRegistry()).Default(tc.policy)
+
+			// --- When ---
+			have, conflicts, err := mrg.Merge(tc.set0, tc.set1)
+
+			// --- Then ---
+			assert.ErrorIs(t, tc.err, err)
+			assert.ErrorEqual(t, tc.exp, err)
+			assert.Zero(t, have)
+			assert.Nil(t, conflicts)
+		})
+	}
+}
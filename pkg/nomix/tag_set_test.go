@@ -215,3 +215,148 @@ func Test_TagSet_MetaGetAll(t *testing.T) {
 		assert.Nil(t, set.MetaGetAll())
 	})
 }
+
+func Test_TagSet_MetaAppend(t *testing.T) {
+	// --- Given ---
+	set := tstSet(tstInt("A", 1), tstStr("B", "b"))
+	m := map[string]any{"B": 0, "C": 3}
+
+	// --- When ---
+	set.MetaAppend(m)
+
+	// --- Then ---
+	assert.Equal(t, map[string]any{"A": 1, "B": "b", "C": 3}, m)
+}
+
+func Test_TagSet_TagAll(t *testing.T) {
+	t.Run("all", func(t *testing.T) {
+		// --- Given ---
+		set := tstSet(tstInt("A", 1), tstInt("B", 2))
+
+		// --- When ---
+		have := make(map[string]Tag)
+		for name, tag := range set.TagAll() {
+			have[name] = tag
+		}
+
+		// --- Then ---
+		assert.Equal(t, set.TagGetAll(), have)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		// --- Given ---
+		set := tstSet(tstInt("A", 1), tstInt("B", 2))
+
+		// --- When ---
+		var cnt int
+		for range set.TagAll() {
+			cnt++
+			break
+		}
+
+		// --- Then ---
+		assert.Equal(t, 1, cnt)
+	})
+}
+
+func Test_TagSet_TagSorted(t *testing.T) {
+	t.Run("sorted", func(t *testing.T) {
+		// --- Given ---
+		set := tstSet(tstInt("C", 3), tstInt("A", 1), tstStr("B", "b"))
+
+		// --- When ---
+		var have []string
+		for name, tag := range set.TagSorted() {
+			assert.Equal(t, name, tag.TagName())
+			have = append(have, name)
+		}
+
+		// --- Then ---
+		assert.Equal(t, []string{"A", "B", "C"}, have)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		// --- Given ---
+		set := tstSet(tstInt("C", 3), tstInt("A", 1), tstStr("B", "b"))
+
+		// --- When ---
+		var have []string
+		for name := range set.TagSorted() {
+			have = append(have, name)
+			if name == "B" {
+				break
+			}
+		}
+
+		// --- Then ---
+		assert.Equal(t, []string{"A", "B"}, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		set := NewTagSet()
+
+		// --- When ---
+		var have []string
+		for name := range set.TagSorted() {
+			have = append(have, name)
+		}
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_TagSet_TagByKind(t *testing.T) {
+	// --- Given ---
+	set := tstSet(
+		tstInt("C", 3),
+		tstInt("A", 1),
+		tstStr("B", "b"),
+		tstInts("D", 4),
+	)
+
+	// --- When ---
+	var have []string
+	for name := range set.TagByKind(KindInt) {
+		have = append(have, name)
+	}
+
+	// --- Then ---
+	assert.Equal(t, []string{"A", "C"}, have)
+}
+
+func Test_TagSet_TagByPrefix(t *testing.T) {
+	t.Run("prefix", func(t *testing.T) {
+		// --- Given ---
+		set := tstSet(
+			tstInt("app.b", 2),
+			tstInt("app.a", 1),
+			tstInt("apps", 3),
+			tstInt("env", 4),
+		)
+
+		// --- When ---
+		var have []string
+		for name := range set.TagByPrefix("app.") {
+			have = append(have, name)
+		}
+
+		// --- Then ---
+		assert.Equal(t, []string{"app.a", "app.b"}, have)
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		// --- Given ---
+		set := tstSet(tstInt("env", 4))
+
+		// --- When ---
+		var have []string
+		for name := range set.TagByPrefix("app.") {
+			have = append(have, name)
+		}
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
@@ -41,3 +41,50 @@ func Test_TagKind_String_tabular(t *testing.T) {
 		})
 	}
 }
+
+func Test_Kind_Base_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		knd  Kind
+		want Kind
+	}{
+		{"KindString", KindString, KindString},
+		{"KindInt64", KindInt64, KindInt64},
+		{"KindBool", KindBool, KindInt64},
+		{"KindInt", KindInt, KindInt64},
+		{"KindJSON", KindJSON, KindJSON},
+		{"KindByteSlice", KindByteSlice, 0b00000000_00000001},
+		{"KindStringSlice", KindStringSlice, KindString},
+		{"KindIntSlice", KindIntSlice, KindInt64},
+		{"KindUUIDSlice", KindUUIDSlice, KindUUID},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.knd.Base()
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_Kind_IsSlice(t *testing.T) {
+	t.Run("slice", func(t *testing.T) {
+		// --- When ---
+		have := KindIntSlice.IsSlice()
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not slice", func(t *testing.T) {
+		// --- When ---
+		have := KindInt.IsSlice()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
@@ -0,0 +1,398 @@
+// Code generated by nomixgen. DO NOT EDIT.
+
+package sample
+
+import (
+	"time"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+	"github.com/ctx42/verax/pkg/verax"
+)
+
+// Definitions of the [Asset] tags.
+var (
+	AssetCanaryDef     = nomix.Define("canary", xtag.BoolSpec())
+	AssetCreatedDef    = nomix.Define("created", xtag.TimeSpec())
+	AssetDocDef        = nomix.Define("doc", xtag.JSONSpec())
+	AssetEnvDef        = nomix.Define("env", xtag.StringSpec(), verax.In("prod", "qa"))
+	AssetFlagsDef      = nomix.Define("flags", xtag.BoolSliceSpec())
+	AssetLoadDef       = nomix.Define("load", xtag.Float64Spec(), verax.Min(float64(0)), verax.Max(float64(1)))
+	AssetNameDef       = nomix.Define("name", xtag.StringSpec(), verax.Length(1, 64))
+	AssetOwnerIDDef    = nomix.Define("owner_id", xtag.UUIDSpec())
+	AssetPortsDef      = nomix.Define("ports", xtag.IntSliceSpec())
+	AssetQuotaDef      = nomix.Define("quota", xtag.Int64Spec())
+	AssetRawDef        = nomix.Define("raw", xtag.ByteSliceSpec())
+	AssetRelatedIdsDef = nomix.Define("related_ids", xtag.UUIDSliceSpec())
+	AssetReplicasDef   = nomix.Define("replicas", xtag.IntSpec(), verax.Min(1), verax.Max(9))
+	AssetSizesDef      = nomix.Define("sizes", xtag.Int64SliceSpec())
+	AssetUpdatesDef    = nomix.Define("updates", xtag.TimeSliceSpec())
+	AssetWeightsDef    = nomix.Define("weights", xtag.Float64SliceSpec())
+	AssetZonesDef      = nomix.Define("zones", xtag.StringSliceSpec(), verax.Length(1, 0))
+)
+
+// Asset is a typed wrapper around [nomix.TagSet].
+type Asset struct{ set nomix.TagSet }
+
+// NewAsset returns a new instance of [Asset] wrapping the set.
+func NewAsset(set nomix.TagSet) Asset { return Asset{set: set} }
+
+// Tags returns the wrapped [nomix.TagSet].
+func (w Asset) Tags() nomix.TagSet { return w.set }
+
+// Canary returns the "canary" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Canary() (bool, bool) {
+	v, err := nomix.GetTagValue[bool](w.set, "canary")
+	return v, err == nil
+}
+
+// SetCanary sets the "canary" tag.
+//
+// The value is validated with [AssetCanaryDef].
+func (w Asset) SetCanary(v bool) error {
+	tag, err := AssetCanaryDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// Created returns the "created" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Created() (time.Time, bool) {
+	v, err := nomix.GetTagValue[time.Time](w.set, "created")
+	return v, err == nil
+}
+
+// SetCreated sets the "created" tag.
+//
+// The value is validated with [AssetCreatedDef].
+func (w Asset) SetCreated(v time.Time) error {
+	tag, err := AssetCreatedDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// Doc returns the "doc" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Doc() ([]byte, bool) {
+	v, err := nomix.GetTagValue[[]byte](w.set, "doc")
+	return v, err == nil
+}
+
+// SetDoc sets the "doc" tag.
+//
+// The value is validated with [AssetDocDef].
+func (w Asset) SetDoc(v []byte) error {
+	tag, err := AssetDocDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// Env returns the "env" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Env() (string, bool) {
+	v, err := nomix.GetTagValue[string](w.set, "env")
+	return v, err == nil
+}
+
+// SetEnv sets the "env" tag.
+//
+// The value is validated with [AssetEnvDef].
+func (w Asset) SetEnv(v string) error {
+	tag, err := AssetEnvDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// Flags returns the "flags" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Flags() ([]bool, bool) {
+	v, err := nomix.GetTagValue[[]bool](w.set, "flags")
+	return v, err == nil
+}
+
+// SetFlags sets the "flags" tag.
+//
+// The value is validated with [AssetFlagsDef].
+func (w Asset) SetFlags(v []bool) error {
+	tag, err := AssetFlagsDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// Load returns the "load" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Load() (float64, bool) {
+	v, err := nomix.GetTagValue[float64](w.set, "load")
+	return v, err == nil
+}
+
+// SetLoad sets the "load" tag.
+//
+// The value is validated with [AssetLoadDef].
+func (w Asset) SetLoad(v float64) error {
+	tag, err := AssetLoadDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// Name returns the "name" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Name() (string, bool) {
+	v, err := nomix.GetTagValue[string](w.set, "name")
+	return v, err == nil
+}
+
+// SetName sets the "name" tag.
+//
+// The value is validated with [AssetNameDef].
+func (w Asset) SetName(v string) error {
+	tag, err := AssetNameDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// OwnerID returns the "owner_id" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) OwnerID() ([16]byte, bool) {
+	v, err := nomix.GetTagValue[[16]byte](w.set, "owner_id")
+	return v, err == nil
+}
+
+// SetOwnerID sets the "owner_id" tag.
+//
+// The value is validated with [AssetOwnerIDDef].
+func (w Asset) SetOwnerID(v [16]byte) error {
+	tag, err := AssetOwnerIDDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// Ports returns the "ports" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Ports() ([]int, bool) {
+	v, err := nomix.GetTagValue[[]int](w.set, "ports")
+	return v, err == nil
+}
+
+// SetPorts sets the "ports" tag.
+//
+// The value is validated with [AssetPortsDef].
+func (w Asset) SetPorts(v []int) error {
+	tag, err := AssetPortsDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// Quota returns the "quota" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Quota() (int64, bool) {
+	v, err := nomix.GetTagValue[int64](w.set, "quota")
+	return v, err == nil
+}
+
+// SetQuota sets the "quota" tag.
+//
+// The value is validated with [AssetQuotaDef].
+func (w Asset) SetQuota(v int64) error {
+	tag, err := AssetQuotaDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// Raw returns the "raw" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Raw() ([]byte, bool) {
+	v, err := nomix.GetTagValue[[]byte](w.set, "raw")
+	return v, err == nil
+}
+
+// SetRaw sets the "raw" tag.
+//
+// The value is validated with [AssetRawDef].
+func (w Asset) SetRaw(v []byte) error {
+	tag, err := AssetRawDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// RelatedIds returns the "related_ids" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) RelatedIds() ([][16]byte, bool) {
+	v, err := nomix.GetTagValue[[][16]byte](w.set, "related_ids")
+	return v, err == nil
+}
+
+// SetRelatedIds sets the "related_ids" tag.
+//
+// The value is validated with [AssetRelatedIdsDef].
+func (w Asset) SetRelatedIds(v [][16]byte) error {
+	tag, err := AssetRelatedIdsDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// Replicas returns the "replicas" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Replicas() (int, bool) {
+	v, err := nomix.GetTagValue[int](w.set, "replicas")
+	return v, err == nil
+}
+
+// SetReplicas sets the "replicas" tag.
+//
+// The value is validated with [AssetReplicasDef].
+func (w Asset) SetReplicas(v int) error {
+	tag, err := AssetReplicasDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// Sizes returns the "sizes" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Sizes() ([]int64, bool) {
+	v, err := nomix.GetTagValue[[]int64](w.set, "sizes")
+	return v, err == nil
+}
+
+// SetSizes sets the "sizes" tag.
+//
+// The value is validated with [AssetSizesDef].
+func (w Asset) SetSizes(v []int64) error {
+	tag, err := AssetSizesDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// Updates returns the "updates" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Updates() ([]time.Time, bool) {
+	v, err := nomix.GetTagValue[[]time.Time](w.set, "updates")
+	return v, err == nil
+}
+
+// SetUpdates sets the "updates" tag.
+//
+// The value is validated with [AssetUpdatesDef].
+func (w Asset) SetUpdates(v []time.Time) error {
+	tag, err := AssetUpdatesDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// Weights returns the "weights" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Weights() ([]float64, bool) {
+	v, err := nomix.GetTagValue[[]float64](w.set, "weights")
+	return v, err == nil
+}
+
+// SetWeights sets the "weights" tag.
+//
+// The value is validated with [AssetWeightsDef].
+func (w Asset) SetWeights(v []float64) error {
+	tag, err := AssetWeightsDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// Zones returns the "zones" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Zones() ([]string, bool) {
+	v, err := nomix.GetTagValue[[]string](w.set, "zones")
+	return v, err == nil
+}
+
+// SetZones sets the "zones" tag.
+//
+// The value is validated with [AssetZonesDef].
+func (w Asset) SetZones(v []string) error {
+	tag, err := AssetZonesDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
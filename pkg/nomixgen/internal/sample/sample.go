@@ -0,0 +1,9 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+// Package sample holds the code generated by nomixgen from the asset.json
+// JSON Schema with tags of all supported kinds. It is built with the module,
+// so the generated code is checked by the compiler.
+package sample
+
+//go:generate go run ../../../../cmd/nomixgen -in asset.json -type Asset
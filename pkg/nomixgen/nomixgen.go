@@ -0,0 +1,307 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+// Package nomixgen generates typed wrappers around [nomix.TagSet] from tag
+// definitions described by JSON Schema documents.
+//
+// For each definition, the wrapper has a getter returning the tag value of
+// the Go type matching the definition [nomix.Kind], and a setter creating
+// the tag with the [xtag] constructors and validating it with the
+// definition rules.
+package nomixgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/ctx42/verax/pkg/spec"
+	"github.com/ctx42/verax/pkg/verax"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+)
+
+// kindType represents the Go type and the [xtag] spec of a [nomix.Kind].
+type kindType struct {
+	typ  string // Go type of the tag value.
+	spec string // Function returning the xtag spec.
+}
+
+// kindTypes maps supported kinds to Go types and [xtag] specs.
+var kindTypes = map[nomix.Kind]kindType{
+	nomix.KindString:       {"string", "StringSpec"},
+	nomix.KindInt:          {"int", "IntSpec"},
+	nomix.KindInt64:        {"int64", "Int64Spec"},
+	nomix.KindFloat64:      {"float64", "Float64Spec"},
+	nomix.KindBool:         {"bool", "BoolSpec"},
+	nomix.KindTime:         {"time.Time", "TimeSpec"},
+	nomix.KindJSON:         {"[]byte", "JSONSpec"},
+	nomix.KindUUID:         {"[16]byte", "UUIDSpec"},
+	nomix.KindByteSlice:    {"[]byte", "ByteSliceSpec"},
+	nomix.KindStringSlice:  {"[]string", "StringSliceSpec"},
+	nomix.KindIntSlice:     {"[]int", "IntSliceSpec"},
+	nomix.KindInt64Slice:   {"[]int64", "Int64SliceSpec"},
+	nomix.KindFloat64Slice: {"[]float64", "Float64SliceSpec"},
+	nomix.KindBoolSlice:    {"[]bool", "BoolSliceSpec"},
+	nomix.KindTimeSlice:    {"[]time.Time", "TimeSliceSpec"},
+	nomix.KindUUIDSlice:    {"[][16]byte", "UUIDSliceSpec"},
+}
+
+// initialisms are tag name parts written in upper case in Go identifiers.
+var initialisms = []string{"API", "HTTP", "ID", "IP", "JSON", "URL", "UUID"}
+
+// reserved are method names of the generated type.
+var reserved = []string{"Tags"}
+
+// ruleSpec is a validation rule holding its [spec.Spec]. It is used to
+// generate the code creating the rule.
+type ruleSpec struct{ spc *spec.Spec }
+
+// Validate implements [verax.Rule] interface. It never fails.
+func (r ruleSpec) Validate(any) error { return nil }
+
+// decodeRule implements [nomix.RuleDecoder] returning [ruleSpec].
+func decodeRule(spc *spec.Spec) (verax.Rule, error) {
+	return ruleSpec{spc: spc}, nil
+}
+
+// field represents a generated tag accessor.
+type field struct {
+	Name   string // Tag name.
+	Method string // Getter method name.
+	Type   string // Go type of the tag value.
+	Define string // Code creating the tag definition.
+}
+
+// file represents a generated file.
+type file struct {
+	Package string  // Package name.
+	Type    string  // Wrapper type name.
+	Time    bool    // The time package is imported.
+	Verax   bool    // The verax package is imported.
+	Fields  []field // Tag accessors.
+}
+
+// Generate returns the formatted Go source of the named type wrapping
+// [nomix.TagSet] in the given package. The tag definitions are decoded from
+// the JSON Schema document with [nomix.UnmarshalJSONSchema], and their
+// validation rules are created with the verax package.
+//
+// Getter and setter names are derived from tag names by removing characters
+// not allowed in Go identifiers and capitalizing words they separate, for
+// example, the "owner_id" tag has the OwnerID and SetOwnerID methods.
+//
+// Returns an error if the document cannot be decoded, the kind of any of the
+// tags is not supported, or the derived method names are not valid or not
+// unique.
+func Generate(pkg, typ string, data []byte) ([]byte, error) {
+	if !token.IsIdentifier(pkg) {
+		return nil, fmt.Errorf("invalid package name: %q", pkg)
+	}
+	if !token.IsIdentifier(typ) || !token.IsExported(typ) {
+		return nil, fmt.Errorf("invalid type name: %q", typ)
+	}
+
+	reg := nomix.NewRegistry()
+	xtag.RegisterAll(reg)
+	defs, err := nomix.UnmarshalJSONSchema(reg, data, decodeRule)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &file{Package: pkg, Type: typ}
+	methods := make(map[string]string, len(defs))
+	for _, def := range defs {
+		fld, err := defField(def)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", def.TagName(), err)
+		}
+		if slices.Contains(reserved, fld.Method) {
+			const format = "%s: method name %q is reserved"
+			return nil, fmt.Errorf(format, def.TagName(), fld.Method)
+		}
+		if name, ok := methods[fld.Method]; ok {
+			const format = "%s: method name %q already used by %q"
+			return nil, fmt.Errorf(format, def.TagName(), fld.Method, name)
+		}
+		methods[fld.Method] = def.TagName()
+		f.Time = f.Time || strings.Contains(fld.Type, "time.")
+		f.Verax = f.Verax || def.TagRule() != nil
+		f.Fields = append(f.Fields, fld)
+	}
+
+	buf := &bytes.Buffer{}
+	if err = tpl.Execute(buf, f); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// defField returns the accessor for the tag definition.
+func defField(def *nomix.Definition) (field, error) {
+	kt, ok := kindTypes[def.TagKind()]
+	if !ok {
+		return field{}, fmt.Errorf("%w: %s", nomix.ErrNotImpl, def.TagKind())
+	}
+	method, err := goName(def.TagName())
+	if err != nil {
+		return field{}, err
+	}
+
+	args := []string{strconv.Quote(def.TagName()), "xtag." + kt.spec + "()"}
+	rules := []verax.Rule{def.TagRule()}
+	if set, ok := def.TagRule().(verax.Set); ok {
+		rules = set
+	}
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+		code, err := ruleCode(rule.(ruleSpec).spc)
+		if err != nil {
+			return field{}, err
+		}
+		args = append(args, code)
+	}
+
+	fld := field{
+		Name:   def.TagName(),
+		Method: method,
+		Type:   kt.typ,
+		Define: "nomix.Define(" + strings.Join(args, ", ") + ")",
+	}
+	return fld, nil
+}
+
+// ruleCode returns the code creating the validation rule.
+func ruleCode(spc *spec.Spec) (string, error) {
+	var fn string
+	var args []any
+	switch spc.Name {
+	case "min", "max":
+		fn = "verax." + strings.ToUpper(spc.Name[:1]) + spc.Name[1:]
+		args = []any{spc.Args[spec.ArgValue]}
+	case "length":
+		fn = "verax.Length"
+		args = []any{spc.Args["min"], spc.Args["max"]}
+	case "in":
+		fn = "verax.In"
+		args, _ = spc.Args[spec.ArgValue].([]any)
+	default:
+		return "", fmt.Errorf("%w: rule %q", nomix.ErrNotImpl, spc.Name)
+	}
+	lits := make([]string, 0, len(args))
+	for _, arg := range args {
+		lit, err := literal(arg)
+		if err != nil {
+			return "", err
+		}
+		lits = append(lits, lit)
+	}
+	return fn + "(" + strings.Join(lits, ", ") + ")", nil
+}
+
+// literal returns the Go literal for the value.
+func literal(val any) (string, error) {
+	switch v := val.(type) {
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return "int64(" + strconv.FormatInt(v, 10) + ")", nil
+	case float64:
+		return "float64(" + strconv.FormatFloat(v, 'g', -1, 64) + ")", nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case string:
+		return strconv.Quote(v), nil
+	default:
+		return "", fmt.Errorf("%w: literal for %T", nomix.ErrNotImpl, val)
+	}
+}
+
+// goName returns the exported Go identifier for the tag name.
+func goName(name string) (string, error) {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var sb strings.Builder
+	for _, part := range parts {
+		if idx := slices.IndexFunc(initialisms, func(s string) bool {
+			return strings.EqualFold(s, part)
+		}); idx >= 0 {
+			sb.WriteString(initialisms[idx])
+			continue
+		}
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		sb.WriteString(string(runes))
+	}
+	id := sb.String()
+	if !token.IsIdentifier(id) || !token.IsExported(id) {
+		return "", fmt.Errorf("invalid method name: %q", id)
+	}
+	return id, nil
+}
+
+// tpl is the template of the generated file.
+var tpl = template.Must(template.New("file").Parse(`// Code generated by nomixgen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+{{- if .Time }}
+	"time"
+{{ end }}
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+{{- if .Verax }}
+	"github.com/ctx42/verax/pkg/verax"
+{{- end }}
+)
+{{ $typ := .Type }}
+{{- if .Fields }}
+// Definitions of the [{{ $typ }}] tags.
+var (
+{{- range .Fields }}
+	{{ $typ }}{{ .Method }}Def = {{ .Define }}
+{{- end }}
+)
+{{ end }}
+// {{ $typ }} is a typed wrapper around [nomix.TagSet].
+type {{ $typ }} struct{ set nomix.TagSet }
+
+// New{{ $typ }} returns a new instance of [{{ $typ }}] wrapping the set.
+func New{{ $typ }}(set nomix.TagSet) {{ $typ }} { return {{ $typ }}{set: set} }
+
+// Tags returns the wrapped [nomix.TagSet].
+func (w {{ $typ }}) Tags() nomix.TagSet { return w.set }
+{{ range .Fields }}
+// {{ .Method }} returns the "{{ .Name }}" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w {{ $typ }}) {{ .Method }}() ({{ .Type }}, bool) {
+	v, err := nomix.GetTagValue[{{ .Type }}](w.set, {{ printf "%q" .Name }})
+	return v, err == nil
+}
+
+// Set{{ .Method }} sets the "{{ .Name }}" tag.
+//
+// The value is validated with [{{ $typ }}{{ .Method }}Def].
+func (w {{ $typ }}) Set{{ .Method }}(v {{ .Type }}) error {
+	tag, err := {{ $typ }}{{ .Method }}Def.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+{{ end -}}
+`))
@@ -0,0 +1,345 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomixgen
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/verax/pkg/spec"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+func Test_Generate(t *testing.T) {
+	t.Run("wrapper", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"type": "object", "properties": {
+			"owner_id": {"type": "string", "format": "uuid"},
+			"created": {"type": "string", "format": "date-time"},
+			"replicas": {"type": "integer", "minimum": 1, "maximum": 9}
+		}}`)
+
+		// --- When ---
+		have, err := Generate("asset", "Asset", data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `// Code generated by nomixgen. DO NOT EDIT.
+
+package asset
+
+import (
+	"time"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+	"github.com/ctx42/verax/pkg/verax"
+)
+
+// Definitions of the [Asset] tags.
+var (
+	AssetCreatedDef  = nomix.Define("created", xtag.TimeSpec())
+	AssetOwnerIDDef  = nomix.Define("owner_id", xtag.UUIDSpec())
+	AssetReplicasDef = nomix.Define("replicas", xtag.IntSpec(), ` +
+			`verax.Min(1), verax.Max(9))
+)
+
+// Asset is a typed wrapper around [nomix.TagSet].
+type Asset struct{ set nomix.TagSet }
+
+// NewAsset returns a new instance of [Asset] wrapping the set.
+func NewAsset(set nomix.TagSet) Asset { return Asset{set: set} }
+
+// Tags returns the wrapped [nomix.TagSet].
+func (w Asset) Tags() nomix.TagSet { return w.set }
+
+// Created returns the "created" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Created() (time.Time, bool) {
+	v, err := nomix.GetTagValue[time.Time](w.set, "created")
+	return v, err == nil
+}
+
+// SetCreated sets the "created" tag.
+//
+// The value is validated with [AssetCreatedDef].
+func (w Asset) SetCreated(v time.Time) error {
+	tag, err := AssetCreatedDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// OwnerID returns the "owner_id" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) OwnerID() ([16]byte, bool) {
+	v, err := nomix.GetTagValue[[16]byte](w.set, "owner_id")
+	return v, err == nil
+}
+
+// SetOwnerID sets the "owner_id" tag.
+//
+// The value is validated with [AssetOwnerIDDef].
+func (w Asset) SetOwnerID(v [16]byte) error {
+	tag, err := AssetOwnerIDDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+
+// Replicas returns the "replicas" tag value.
+//
+// Returns the zero value and false if the tag doesn't exist or has a
+// different type.
+func (w Asset) Replicas() (int, bool) {
+	v, err := nomix.GetTagValue[int](w.set, "replicas")
+	return v, err == nil
+}
+
+// SetReplicas sets the "replicas" tag.
+//
+// The value is validated with [AssetReplicasDef].
+func (w Asset) SetReplicas(v int) error {
+	tag, err := AssetReplicasDef.TagCreate(v)
+	if err != nil {
+		return err
+	}
+	w.set.TagSet(tag)
+	return nil
+}
+`
+		assert.Equal(t, want, string(have))
+	})
+
+	t.Run("without tags", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"type": "object"}`)
+
+		// --- When ---
+		have, err := Generate("asset", "Asset", data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `// Code generated by nomixgen. DO NOT EDIT.
+
+package asset
+
+import (
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+)
+
+// Asset is a typed wrapper around [nomix.TagSet].
+type Asset struct{ set nomix.TagSet }
+
+// NewAsset returns a new instance of [Asset] wrapping the set.
+func NewAsset(set nomix.TagSet) Asset { return Asset{set: set} }
+
+// Tags returns the wrapped [nomix.TagSet].
+func (w Asset) Tags() nomix.TagSet { return w.set }
+`
+		assert.Equal(t, want, string(have))
+	})
+
+	t.Run("sample package is up to date", func(t *testing.T) {
+		// The sample package is built with the module, which checks the
+		// generated code compiles. Run "go generate" in the package to
+		// update it.
+
+		// --- Given ---
+		data := must.Value(os.ReadFile("internal/sample/asset.json"))
+		want := must.Value(os.ReadFile("internal/sample/asset_tags.go"))
+
+		// --- When ---
+		have, err := Generate("sample", "Asset", data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, string(want), string(have))
+	})
+}
+
+func Test_Generate_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		pkg  string
+		typ  string
+		data string
+		exp  string
+	}{
+		{
+			"invalid package name",
+			"my-pkg",
+			"Asset",
+			`{"type": "object"}`,
+			`invalid package name: "my-pkg"`,
+		},
+		{
+			"not exported type name",
+			"asset",
+			"asset",
+			`{"type": "object"}`,
+			`invalid type name: "asset"`,
+		},
+		{
+			"invalid schema",
+			"asset",
+			"Asset",
+			`{"type": "string"}`,
+			`invalid element format: expected "object" type, got "string"`,
+		},
+		{
+			"invalid method name",
+			"asset",
+			"Asset",
+			`{"type": "object", "properties": {"1st": {"type": "string"}}}`,
+			`1st: invalid method name: "1st"`,
+		},
+		{
+			"reserved method name",
+			"asset",
+			"Asset",
+			`{"type": "object", "properties": {"tags": {"type": "string"}}}`,
+			`tags: method name "Tags" is reserved`,
+		},
+		{
+			"duplicate method name",
+			"asset",
+			"Asset",
+			`{"type": "object", "properties": {
+				"a-b": {"type": "string"},
+				"a_b": {"type": "string"}
+			}}`,
+			`a_b: method name "AB" already used by "a-b"`,
+		},
+		{
+			"enum literal not supported",
+			"asset",
+			"Asset",
+			`{"type": "object", "properties": {"a": {
+				"type": "string",
+				"format": "date-time",
+				"enum": ["2026-01-01T00:00:00Z"]
+			}}}`,
+			"a: not implemented: literal for time.Time",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := Generate(tc.pkg, tc.typ, []byte(tc.data))
+
+			// --- Then ---
+			assert.ErrorEqual(t, tc.exp, err)
+			assert.Nil(t, have)
+		})
+	}
+}
+
+func Test_ruleCode_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		spc *spec.Spec
+		exp string
+	}{
+		{
+			"min",
+			spec.NewSpec("min").SetArg(spec.ArgValue, int64(1)),
+			"verax.Min(int64(1))",
+		},
+		{
+			"max",
+			spec.NewSpec("max").SetArg(spec.ArgValue, 1.5),
+			"verax.Max(float64(1.5))",
+		},
+		{
+			"length",
+			spec.NewSpec("length").SetArg("min", 1).SetArg("max", 3),
+			"verax.Length(1, 3)",
+		},
+		{
+			"in",
+			spec.NewSpec("in").SetArg(spec.ArgValue, []any{true, false}),
+			"verax.In(true, false)",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := ruleCode(tc.spc)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_ruleCode(t *testing.T) {
+	t.Run("error - not supported rule", func(t *testing.T) {
+		// --- When ---
+		have, err := ruleCode(spec.NewSpec("email"))
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrNotImpl, err)
+		assert.ErrorEqual(t, `not implemented: rule "email"`, err)
+		assert.Equal(t, "", have)
+	})
+}
+
+func Test_goName_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		name string
+		exp  string
+	}{
+		{"single word", "replicas", "Replicas"},
+		{"capitalized", "Replicas", "Replicas"},
+		{"snake case", "max_replicas", "MaxReplicas"},
+		{"kebab case", "max-replicas", "MaxReplicas"},
+		{"dotted", "app.max.replicas", "AppMaxReplicas"},
+		{"camel case", "maxReplicas", "MaxReplicas"},
+		{"initialism", "owner_id", "OwnerID"},
+		{"initialism only", "url", "URL"},
+		{"digits", "ipv4_addr", "Ipv4Addr"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := goName(tc.name)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_goName(t *testing.T) {
+	t.Run("error - empty", func(t *testing.T) {
+		// --- When ---
+		have, err := goName("-")
+
+		// --- Then ---
+		assert.ErrorEqual(t, `invalid method name: ""`, err)
+		assert.Equal(t, "", have)
+	})
+}
@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomixsql
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+// columnTypes maps table columns to their types for each dialect.
+var columnTypes = map[Dialect]map[string]string{
+	PostgreSQL: {
+		ColOwner:   "TEXT NOT NULL",
+		ColName:    "TEXT NOT NULL",
+		ColKind:    "SMALLINT NOT NULL",
+		ColIdx:     "INTEGER NOT NULL",
+		ColString:  "TEXT",
+		ColInt64:   "BIGINT",
+		ColFloat64: "DOUBLE PRECISION",
+		ColTime:    "TIMESTAMPTZ",
+		ColJSON:    "JSONB",
+		ColUUID:    "UUID",
+		ColBytes:   "BYTEA",
+	},
+	MySQL: {
+		ColOwner:   "VARCHAR(255) NOT NULL",
+		ColName:    "VARCHAR(255) NOT NULL",
+		ColKind:    "SMALLINT NOT NULL",
+		ColIdx:     "INT NOT NULL",
+		ColString:  "TEXT",
+		ColInt64:   "BIGINT",
+		ColFloat64: "DOUBLE",
+		ColTime:    "DATETIME(6)",
+		ColJSON:    "JSON",
+		ColUUID:    "CHAR(36)",
+		ColBytes:   "LONGBLOB",
+	},
+	SQLite: {
+		ColOwner:   "TEXT NOT NULL",
+		ColName:    "TEXT NOT NULL",
+		ColKind:    "INTEGER NOT NULL",
+		ColIdx:     "INTEGER NOT NULL",
+		ColString:  "TEXT",
+		ColInt64:   "INTEGER",
+		ColFloat64: "REAL",
+		ColTime:    "TIMESTAMP",
+		ColJSON:    "TEXT",
+		ColUUID:    "TEXT",
+		ColBytes:   "BLOB",
+	},
+}
+
+// ColumnType returns the type of the table column in the dialect. Returns an
+// empty string for unknown columns or dialects.
+func (d Dialect) ColumnType(col string) string { return columnTypes[d][col] }
+
+// DDL returns statements creating the tag table and its indexes, configured
+// with the [Options.Table] and [Options.Dialect] options.
+//
+// The table has the primary key on the owner ID, tag name and element index
+// columns. For each base kind of kinds registered in the registry, an index
+// on the tag name and the value column is created, so tags may be looked up
+// by their values. The JSON column is indexed with GIN on PostgreSQL, and
+// not indexed on MySQL. Slice kinds use the same value columns as their
+// element kinds, since each element is stored in a separate row.
+//
+// For each definition a partial index, limited to rows of the defined tag,
+// is created on its value column. MySQL doesn't support partial indexes,
+// hence the definitions are only validated. No index is created for byte
+// slices.
+//
+// Returns an error if any of the registered kinds cannot be stored, or a
+// definition's kind is not registered in the registry.
+func DDL(
+	reg *nomix.Registry,
+	defs []*nomix.Definition,
+	opts ...Option,
+) ([]string, error) {
+
+	ops := NewOptions(opts...)
+	if _, ok := columnTypes[ops.Dialect]; !ok {
+		const format = "%w: dialect %[2]s(%[2]d)"
+		return nil, fmt.Errorf(format, nomix.ErrInvValue, ops.Dialect)
+	}
+
+	var cols []string
+	for _, knd := range reg.Kinds() {
+		col, err := Column(knd)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(cols, col) {
+			cols = append(cols, col)
+		}
+	}
+	for _, def := range defs {
+		if reg.SpecForKind(def.TagKind()).IsZero() {
+			const format = "%s: %w for %[3]s(%[3]d)"
+			return nil, fmt.Errorf(
+				format,
+				def.TagName(),
+				nomix.ErrNoSpec,
+				def.TagKind(),
+			)
+		}
+	}
+
+	ddl := &ddlBuilder{opts: ops, names: make(map[string]bool)}
+	stmts := []string{ddl.table()}
+	for _, col := range valueColumns {
+		if col == ColBytes || !slices.Contains(cols, col) {
+			continue
+		}
+		if stmt := ddl.valueIndex(col); stmt != "" {
+			stmts = append(stmts, stmt)
+		}
+	}
+	if ops.Dialect == MySQL {
+		return stmts, nil
+	}
+	for _, def := range defs {
+		col, _ := Column(def.TagKind())
+		if col == ColBytes {
+			continue
+		}
+		stmts = append(stmts, ddl.tagIndex(def.TagName(), col))
+	}
+	return stmts, nil
+}
+
+// ddlBuilder builds DDL statements.
+type ddlBuilder struct {
+	opts  Options         // Store options.
+	names map[string]bool // Used index names.
+}
+
+// table returns the statement creating the tag table.
+func (b *ddlBuilder) table() string {
+	buf := &strings.Builder{}
+	buf.WriteString("CREATE TABLE ")
+	buf.WriteString(b.opts.Table)
+	buf.WriteString(" (\n")
+	for _, col := range columns {
+		buf.WriteString("    ")
+		buf.WriteString(col)
+		buf.WriteString(" ")
+		buf.WriteString(b.opts.Dialect.ColumnType(col))
+		buf.WriteString(",\n")
+	}
+	buf.WriteString("    PRIMARY KEY (")
+	buf.WriteString(strings.Join([]string{ColOwner, ColName, ColIdx}, ", "))
+	buf.WriteString(")\n)")
+	return buf.String()
+}
+
+// valueIndex returns the statement creating the index on the tag name and
+// the value column. Returns an empty string if the column cannot be indexed.
+func (b *ddlBuilder) valueIndex(col string) string {
+	expr := col
+	switch {
+	case b.opts.Dialect == PostgreSQL && col == ColJSON:
+		return fmt.Sprintf(
+			"CREATE INDEX %s ON %s USING GIN (%s)",
+			b.indexName(col),
+			b.opts.Table,
+			col,
+		)
+
+	case b.opts.Dialect == MySQL && col == ColJSON:
+		return "" // MySQL cannot index JSON columns directly.
+
+	case b.opts.Dialect == MySQL && col == ColString:
+		expr += "(255)" // MySQL requires the prefix length for TEXT columns.
+	}
+	return fmt.Sprintf(
+		"CREATE INDEX %s ON %s (%s, %s)",
+		b.indexName(col),
+		b.opts.Table,
+		ColName,
+		expr,
+	)
+}
+
+// tagIndex returns the statement creating the partial index on the value
+// column for rows of the named tag.
+func (b *ddlBuilder) tagIndex(name, col string) string {
+	using := ""
+	if b.opts.Dialect == PostgreSQL && col == ColJSON {
+		using = "USING GIN "
+	}
+	return fmt.Sprintf(
+		"CREATE INDEX %s ON %s %s(%s) WHERE %s = %s",
+		b.indexName("tag_"+name),
+		b.opts.Table,
+		using,
+		col,
+		ColName,
+		quoteLiteral(name),
+	)
+}
+
+// indexName returns a unique index name for the given part. Characters not
+// allowed in identifiers are replaced with underscores.
+func (b *ddlBuilder) indexName(part string) string {
+	base := identifier(b.opts.Table + "_" + part)
+	name := base + "_idx"
+	for i := 2; b.names[name]; i++ {
+		name = fmt.Sprintf("%s_%d_idx", base, i)
+	}
+	b.names[name] = true
+	return name
+}
+
+// identifier returns the string with characters other than ASCII letters,
+// digits and underscores replaced with underscores.
+func identifier(str string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, str)
+}
+
+// quoteLiteral returns the string as the SQL string literal.
+func quoteLiteral(str string) string {
+	return "'" + strings.ReplaceAll(str, "'", "''") + "'"
+}
@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomixsql
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+)
+
+func Test_Dialect_ColumnType_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		dialect Dialect
+		col     string
+		exp     string
+	}{
+		{"PostgreSQL time", PostgreSQL, ColTime, "TIMESTAMPTZ"},
+		{"PostgreSQL json", PostgreSQL, ColJSON, "JSONB"},
+		{"MySQL owner", MySQL, ColOwner, "VARCHAR(255) NOT NULL"},
+		{"MySQL uuid", MySQL, ColUUID, "CHAR(36)"},
+		{"SQLite float64", SQLite, ColFloat64, "REAL"},
+		{"unknown column", SQLite, "abc", ""},
+		{"unknown dialect", Dialect(42), ColName, ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.dialect.ColumnType(tc.col)
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_DDL(t *testing.T) {
+	t.Run("PostgreSQL", func(t *testing.T) {
+		// --- Given ---
+		reg := nomix.NewRegistry()
+		must.Nil(reg.Register(xtag.IntSpec()))
+		must.Nil(reg.Register(xtag.StringSliceSpec()))
+		must.Nil(reg.Register(xtag.JSONSpec()))
+		must.Nil(reg.Register(xtag.ByteSliceSpec()))
+		defs := []*nomix.Definition{
+			nomix.Define("A", xtag.IntSpec()),
+			nomix.Define("b'c", xtag.StringSliceSpec()),
+			nomix.Define("b_c", xtag.StringSliceSpec()),
+			nomix.Define("J", xtag.JSONSpec()),
+			nomix.Define("D", xtag.ByteSliceSpec()),
+		}
+
+		// --- When ---
+		have, err := DDL(reg, defs)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := []string{
+			"CREATE TABLE tags (\n" +
+				"    owner_id TEXT NOT NULL,\n" +
+				"    name TEXT NOT NULL,\n" +
+				"    kind SMALLINT NOT NULL,\n" +
+				"    idx INTEGER NOT NULL,\n" +
+				"    val_string TEXT,\n" +
+				"    val_int64 BIGINT,\n" +
+				"    val_float64 DOUBLE PRECISION,\n" +
+				"    val_time TIMESTAMPTZ,\n" +
+				"    val_json JSONB,\n" +
+				"    val_uuid UUID,\n" +
+				"    val_bytes BYTEA,\n" +
+				"    PRIMARY KEY (owner_id, name, idx)\n" +
+				")",
+			"CREATE INDEX tags_val_string_idx ON tags (name, val_string)",
+			"CREATE INDEX tags_val_int64_idx ON tags (name, val_int64)",
+			"CREATE INDEX tags_val_json_idx ON tags USING GIN (val_json)",
+			"CREATE INDEX tags_tag_A_idx ON tags (val_int64) " +
+				"WHERE name = 'A'",
+			"CREATE INDEX tags_tag_b_c_idx ON tags (val_string) " +
+				"WHERE name = 'b''c'",
+			"CREATE INDEX tags_tag_b_c_2_idx ON tags (val_string) " +
+				"WHERE name = 'b_c'",
+			"CREATE INDEX tags_tag_J_idx ON tags USING GIN (val_json) " +
+				"WHERE name = 'J'",
+		}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("MySQL", func(t *testing.T) {
+		// --- Given ---
+		reg := nomix.NewRegistry()
+		must.Nil(reg.Register(xtag.StringSpec()))
+		must.Nil(reg.Register(xtag.TimeSpec()))
+		must.Nil(reg.Register(xtag.JSONSpec()))
+		defs := []*nomix.Definition{nomix.Define("A", xtag.StringSpec())}
+
+		// --- When ---
+		have, err := DDL(reg, defs, WithDialect(MySQL), WithTable("meta"))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := []string{
+			"CREATE TABLE meta (\n" +
+				"    owner_id VARCHAR(255) NOT NULL,\n" +
+				"    name VARCHAR(255) NOT NULL,\n" +
+				"    kind SMALLINT NOT NULL,\n" +
+				"    idx INT NOT NULL,\n" +
+				"    val_string TEXT,\n" +
+				"    val_int64 BIGINT,\n" +
+				"    val_float64 DOUBLE,\n" +
+				"    val_time DATETIME(6),\n" +
+				"    val_json JSON,\n" +
+				"    val_uuid CHAR(36),\n" +
+				"    val_bytes LONGBLOB,\n" +
+				"    PRIMARY KEY (owner_id, name, idx)\n" +
+				")",
+			"CREATE INDEX meta_val_string_idx ON meta (name, val_string(255))",
+			"CREATE INDEX meta_val_time_idx ON meta (name, val_time)",
+		}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("SQLite", func(t *testing.T) {
+		// --- Given ---
+		reg := nomix.NewRegistry()
+		must.Nil(reg.Register(xtag.UUIDSpec()))
+		must.Nil(reg.Register(xtag.Float64SliceSpec()))
+		defs := []*nomix.Definition{nomix.Define("U", xtag.UUIDSpec())}
+
+		// --- When ---
+		have, err := DDL(reg, defs, WithDialect(SQLite))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := []string{
+			"CREATE TABLE tags (\n" +
+				"    owner_id TEXT NOT NULL,\n" +
+				"    name TEXT NOT NULL,\n" +
+				"    kind INTEGER NOT NULL,\n" +
+				"    idx INTEGER NOT NULL,\n" +
+				"    val_string TEXT,\n" +
+				"    val_int64 INTEGER,\n" +
+				"    val_float64 REAL,\n" +
+				"    val_time TIMESTAMP,\n" +
+				"    val_json TEXT,\n" +
+				"    val_uuid TEXT,\n" +
+				"    val_bytes BLOB,\n" +
+				"    PRIMARY KEY (owner_id, name, idx)\n" +
+				")",
+			"CREATE INDEX tags_val_float64_idx ON tags (name, val_float64)",
+			"CREATE INDEX tags_val_uuid_idx ON tags (name, val_uuid)",
+			"CREATE INDEX tags_tag_U_idx ON tags (val_uuid) WHERE name = 'U'",
+		}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("empty registry", func(t *testing.T) {
+		// --- When ---
+		have, err := DDL(nomix.NewRegistry(), nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 1, have)
+	})
+
+	t.Run("error - unknown dialect", func(t *testing.T) {
+		// --- When ---
+		have, err := DDL(nomix.NewRegistry(), nil, WithDialect(42))
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvValue, err)
+		assert.ErrorEqual(t, "invalid element value: dialect Unknown(42)", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - registered kind cannot be stored", func(t *testing.T) {
+		// --- Given ---
+		reg := nomix.NewRegistry()
+		must.Nil(reg.Register(nomix.NewKindSpec(0x7F, nil, nil)))
+
+		// --- When ---
+		have, err := DDL(reg, nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - defined kind not registered", func(t *testing.T) {
+		// --- Given ---
+		defs := []*nomix.Definition{nomix.Define("A", xtag.IntSpec())}
+
+		// --- When ---
+		have, err := DDL(nomix.NewRegistry(), defs)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrNoSpec, err)
+		assert.ErrorEqual(t, "A: spec not found for KindInt(516)", err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_identifier(t *testing.T) {
+	// --- When ---
+	have := identifier("a-B_9.ż")
+
+	// --- Then ---
+	assert.Equal(t, "a_B_9__", have)
+}
+
+func Test_quoteLiteral(t *testing.T) {
+	// --- When ---
+	have := quoteLiteral("a'b")
+
+	// --- Then ---
+	assert.Equal(t, "'a''b'", have)
+}
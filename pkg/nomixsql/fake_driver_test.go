@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomixsql
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeDriverName is the name of the in-memory fake driver.
+const fakeDriverName = "nomixsql-fake"
+
+func init() { sql.Register(fakeDriverName, fakeDriver{}) }
+
+// fakeDBs holds the fake databases by their DSN.
+var fakeDBs = struct {
+	sync.Mutex
+	m map[string]*fakeDB
+}{m: make(map[string]*fakeDB)}
+
+// fakeDB is the in-memory database understanding queries created by [Store].
+type fakeDB struct {
+	mx      sync.Mutex
+	rows    [][]driver.Value // Table rows.
+	queries []string         // Executed queries.
+	fail    string           // Queries containing it fail.
+	commits int              // Number of committed transactions.
+	backs   int              // Number of rolled back transactions.
+}
+
+// errFake is the error returned by failing fake queries.
+var errFake = errors.New("fake error")
+
+// tstDB returns a new database handle backed by a new fake database.
+func tstDB(t *testing.T) (*sql.DB, *fakeDB) {
+	t.Helper()
+	fdb := &fakeDB{}
+	fakeDBs.Lock()
+	fakeDBs.m[t.Name()] = fdb
+	fakeDBs.Unlock()
+
+	db, err := sql.Open(fakeDriverName, t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = db.Close()
+		fakeDBs.Lock()
+		delete(fakeDBs.m, t.Name())
+		fakeDBs.Unlock()
+	})
+	return db, fdb
+}
+
+// Queries returns executed queries starting with the prefix.
+func (fdb *fakeDB) Queries(prefix string) []string {
+	fdb.mx.Lock()
+	defer fdb.mx.Unlock()
+	var ret []string
+	for _, query := range fdb.queries {
+		if strings.HasPrefix(query, prefix) {
+			ret = append(ret, query)
+		}
+	}
+	return ret
+}
+
+// Rows returns the table rows.
+func (fdb *fakeDB) Rows() [][]driver.Value {
+	fdb.mx.Lock()
+	defer fdb.mx.Unlock()
+	return slices.Clone(fdb.rows)
+}
+
+// exec executes the query.
+func (fdb *fakeDB) exec(query string, args []driver.Value) error {
+	fdb.mx.Lock()
+	defer fdb.mx.Unlock()
+	fdb.queries = append(fdb.queries, query)
+	if fdb.fail != "" && strings.Contains(query, fdb.fail) {
+		return errFake
+	}
+
+	switch {
+	case strings.HasPrefix(query, "INSERT INTO "):
+		for row := range slices.Chunk(args, len(columns)) {
+			fdb.rows = append(fdb.rows, row)
+		}
+		return nil
+
+	case strings.HasPrefix(query, "DELETE FROM "):
+		match := fakeMatcher(query, args)
+		fdb.rows = slices.DeleteFunc(fdb.rows, match)
+		return nil
+	}
+	return errors.New("fake: unsupported query: " + query)
+}
+
+// query executes the query returning rows.
+func (fdb *fakeDB) query(query string, args []driver.Value) (*fakeRows, error) {
+	fdb.mx.Lock()
+	defer fdb.mx.Unlock()
+	fdb.queries = append(fdb.queries, query)
+	if fdb.fail != "" && strings.Contains(query, fdb.fail) {
+		return nil, errFake
+	}
+	if !strings.HasPrefix(query, "SELECT ") {
+		return nil, errors.New("fake: unsupported query: " + query)
+	}
+
+	match := fakeMatcher(query, args)
+	var rows [][]driver.Value
+	for _, row := range fdb.rows {
+		if match(row) {
+			rows = append(rows, row)
+		}
+	}
+	slices.SortStableFunc(rows, func(a, b []driver.Value) int {
+		return cmp.Or(
+			strings.Compare(a[0].(string), b[0].(string)),
+			strings.Compare(a[1].(string), b[1].(string)),
+			cmp.Compare(a[3].(int64), b[3].(int64)),
+		)
+	})
+	return &fakeRows{rows: rows}, nil
+}
+
+// fakeMatcher returns a function matching rows against the "owner_id IN"
+// and optional "name IN" conditions in the query.
+func fakeMatcher(query string, args []driver.Value) func([]driver.Value) bool {
+	owners := fakeInArgs(query, ColOwner, args)
+	names := fakeInArgs(query, ColName, args[len(owners):])
+	return func(row []driver.Value) bool {
+		if !slices.Contains(owners, row[0]) {
+			return false
+		}
+		return names == nil || slices.Contains(names, row[1])
+	}
+}
+
+// fakeInArgs returns arguments for the "col IN (...)" condition in the query,
+// assuming the condition's arguments start the args slice. Returns nil if
+// the query has no such condition.
+func fakeInArgs(query, col string, args []driver.Value) []driver.Value {
+	_, list, found := strings.Cut(query, "WHERE "+col+" IN (")
+	if !found {
+		_, list, found = strings.Cut(query, "AND "+col+" IN (")
+	}
+	if !found {
+		return nil
+	}
+	list, _, _ = strings.Cut(list, ")")
+	return args[:strings.Count(list, ",")+1]
+}
+
+// fakeDriver is the in-memory fake [driver.Driver].
+type fakeDriver struct{}
+
+func (fakeDriver) Open(dsn string) (driver.Conn, error) {
+	fakeDBs.Lock()
+	defer fakeDBs.Unlock()
+	fdb, ok := fakeDBs.m[dsn]
+	if !ok {
+		return nil, errors.New("fake: unknown database: " + dsn)
+	}
+	return &fakeConn{db: fdb}, nil
+}
+
+// fakeConn is the fake [driver.Conn].
+type fakeConn struct {
+	db     *fakeDB
+	backup [][]driver.Value // Rows before the transaction started.
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, query: query}, nil
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(
+	_ context.Context,
+	_ driver.TxOptions,
+) (driver.Tx, error) {
+
+	c.db.mx.Lock()
+	defer c.db.mx.Unlock()
+	c.backup = slices.Clone(c.db.rows)
+	return c, nil
+}
+
+func (c *fakeConn) Commit() error {
+	c.db.mx.Lock()
+	defer c.db.mx.Unlock()
+	c.db.commits++
+	c.backup = nil
+	return nil
+}
+
+func (c *fakeConn) Rollback() error {
+	c.db.mx.Lock()
+	defer c.db.mx.Unlock()
+	c.db.backs++
+	c.db.rows = c.backup
+	c.backup = nil
+	return nil
+}
+
+// fakeStmt is the fake [driver.Stmt].
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if err := s.conn.db.exec(s.query, args); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.conn.db.query(s.query, args)
+}
+
+// fakeRows is the fake [driver.Rows].
+type fakeRows struct {
+	rows [][]driver.Value
+	pos  int
+}
+
+func (r *fakeRows) Columns() []string { return slices.Clone(columns) }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.pos])
+	r.pos++
+	return nil
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+// Package nomixsql provides storage of tag sets in SQL databases using the
+// entity-attribute-value (EAV) model.
+//
+// Each tag is stored as one or more rows in a single table. Every row holds
+// the owner ID, the tag name, the tag [nomix.Kind], the element index, and
+// the value in the column matching the base kind of the tag. Slice tags use
+// one row per element, except the byte slices which are stored in a single
+// row. Empty slices are stored as a single row with index -1 and no value.
+package nomixsql
+
+import (
+	"fmt"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+// Table columns.
+const (
+	ColOwner   = "owner_id"    // Owner ID.
+	ColName    = "name"        // Tag name.
+	ColKind    = "kind"        // Tag kind.
+	ColIdx     = "idx"         // Element index.
+	ColString  = "val_string"  // Value of the [nomix.KindString] base kind.
+	ColInt64   = "val_int64"   // Value of the [nomix.KindInt64] base kind.
+	ColFloat64 = "val_float64" // Value of the [nomix.KindFloat64] base kind.
+	ColTime    = "val_time"    // Value of the [nomix.KindTime] base kind.
+	ColJSON    = "val_json"    // Value of the [nomix.KindJSON] base kind.
+	ColUUID    = "val_uuid"    // Value of the [nomix.KindUUID] base kind.
+	ColBytes   = "val_bytes"   // Value of the [nomix.KindByteSlice] kind.
+)
+
+// DefaultTable is the default name of the tag table.
+const DefaultTable = "tags"
+
+// columns lists all table columns in the order used in queries.
+var columns = []string{
+	ColOwner,
+	ColName,
+	ColKind,
+	ColIdx,
+	ColString,
+	ColInt64,
+	ColFloat64,
+	ColTime,
+	ColJSON,
+	ColUUID,
+	ColBytes,
+}
+
+// valueColumns lists the value columns in the order used in queries.
+var valueColumns = columns[4:]
+
+// kindByte is the base kind of [nomix.KindByteSlice].
+const kindByte = nomix.KindByteSlice & ^nomix.KindSlice
+
+// Column returns the name of the value column for the base of the given
+// kind. Returns an error if the kind cannot be stored.
+func Column(knd nomix.Kind) (string, error) {
+	switch knd.Base() {
+	case nomix.KindString:
+		return ColString, nil
+	case nomix.KindInt64:
+		return ColInt64, nil
+	case nomix.KindFloat64:
+		return ColFloat64, nil
+	case nomix.KindTime:
+		return ColTime, nil
+	case nomix.KindJSON:
+		return ColJSON, nil
+	case nomix.KindUUID:
+		return ColUUID, nil
+	case kindByte:
+		return ColBytes, nil
+	}
+	return "", fmt.Errorf("%w: kind %[2]s(%[2]d)", nomix.ErrInvType, knd)
+}
+
+// Dialect represents the SQL dialect.
+type Dialect int
+
+// Supported SQL dialects.
+const (
+	PostgreSQL Dialect = iota // PostgreSQL.
+	MySQL                     // MySQL.
+	SQLite                    // SQLite.
+)
+
+// String implements [fmt.Stringer].
+func (d Dialect) String() string {
+	switch d {
+	case PostgreSQL:
+		return "PostgreSQL"
+	case MySQL:
+		return "MySQL"
+	case SQLite:
+		return "SQLite"
+	default:
+		return "Unknown"
+	}
+}
+
+// Placeholder returns the query parameter placeholder for the n-th (starting
+// from 1) parameter.
+func (d Dialect) Placeholder(n int) string {
+	if d == PostgreSQL {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
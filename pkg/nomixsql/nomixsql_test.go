@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomixsql
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+func Test_Column_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		knd nomix.Kind
+		exp string
+	}{
+		{"string", nomix.KindString, ColString},
+		{"int64", nomix.KindInt64, ColInt64},
+		{"int", nomix.KindInt, ColInt64},
+		{"bool", nomix.KindBool, ColInt64},
+		{"float64", nomix.KindFloat64, ColFloat64},
+		{"time", nomix.KindTime, ColTime},
+		{"json", nomix.KindJSON, ColJSON},
+		{"uuid", nomix.KindUUID, ColUUID},
+		{"byte slice", nomix.KindByteSlice, ColBytes},
+		{"string slice", nomix.KindStringSlice, ColString},
+		{"bool slice", nomix.KindBoolSlice, ColInt64},
+		{"uuid slice", nomix.KindUUIDSlice, ColUUID},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := Column(tc.knd)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_Column(t *testing.T) {
+	t.Run("error - unsupported kind", func(t *testing.T) {
+		// --- When ---
+		have, err := Column(0x03)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.ErrorContain(t, "(3)", err)
+		assert.Equal(t, "", have)
+	})
+}
+
+func Test_Dialect_String_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		dialect Dialect
+		exp     string
+	}{
+		{"PostgreSQL", PostgreSQL, "PostgreSQL"},
+		{"MySQL", MySQL, "MySQL"},
+		{"SQLite", SQLite, "SQLite"},
+		{"unknown", Dialect(42), "Unknown"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.dialect.String()
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_Dialect_Placeholder_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		dialect Dialect
+		exp     string
+	}{
+		{"PostgreSQL", PostgreSQL, "$3"},
+		{"MySQL", MySQL, "?"},
+		{"SQLite", SQLite, "?"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.dialect.Placeholder(3)
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomixsql
+
+import (
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+// Option represents an option function.
+type Option func(*Options)
+
+// Options represent a set of options used by [Store].
+type Options struct {
+	// Name of the tag table.
+	Table string
+
+	// SQL dialect.
+	Dialect Dialect
+
+	// Maximal number of rows inserted, or owners loaded, with a single query.
+	BatchSize int
+
+	// Options passed to [nomix.KindSpec.TagParse] when loading tags.
+	//
+	// Loaded values are parsed from their base representation, that is, base
+	// 10 integers and [time.RFC3339Nano] times, hence the options must not
+	// change the radix or the time format.
+	TagOptions []nomix.Option
+}
+
+// NewOptions returns a new [Options] instance with default values.
+func NewOptions(opts ...Option) Options {
+	o := Options{
+		Table:     DefaultTable,
+		Dialect:   PostgreSQL,
+		BatchSize: 100,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithTable sets the name of the tag table.
+func WithTable(table string) Option {
+	return func(opts *Options) { opts.Table = table }
+}
+
+// WithDialect sets the SQL dialect.
+func WithDialect(dialect Dialect) Option {
+	return func(opts *Options) { opts.Dialect = dialect }
+}
+
+// WithBatchSize sets the maximal number of rows inserted, or owners loaded,
+// with a single query. Values less than one are ignored.
+func WithBatchSize(n int) Option {
+	return func(opts *Options) {
+		if n > 0 {
+			opts.BatchSize = n
+		}
+	}
+}
+
+// WithTagOptions sets the options passed to [nomix.KindSpec.TagParse] when
+// loading tags.
+func WithTagOptions(opts ...nomix.Option) Option {
+	return func(o *Options) { o.TagOptions = opts }
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomixsql
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+func Test_NewOptions(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		// --- When ---
+		have := NewOptions()
+
+		// --- Then ---
+		assert.Equal(t, DefaultTable, have.Table)
+		assert.Equal(t, PostgreSQL, have.Dialect)
+		assert.Equal(t, 100, have.BatchSize)
+		assert.Nil(t, have.TagOptions)
+		assert.Fields(t, 4, have)
+	})
+
+	t.Run("with changes", func(t *testing.T) {
+		// --- When ---
+		have := NewOptions(WithTable("meta"), WithDialect(SQLite))
+
+		// --- Then ---
+		assert.Equal(t, "meta", have.Table)
+		assert.Equal(t, SQLite, have.Dialect)
+		assert.Equal(t, 100, have.BatchSize)
+		assert.Nil(t, have.TagOptions)
+		assert.Fields(t, 4, have)
+	})
+}
+
+func Test_WithTable(t *testing.T) {
+	// --- Given ---
+	opts := &Options{}
+
+	// --- When ---
+	WithTable("meta")(opts)
+
+	// --- Then ---
+	assert.Equal(t, "meta", opts.Table)
+}
+
+func Test_WithDialect(t *testing.T) {
+	// --- Given ---
+	opts := &Options{}
+
+	// --- When ---
+	WithDialect(MySQL)(opts)
+
+	// --- Then ---
+	assert.Equal(t, MySQL, opts.Dialect)
+}
+
+func Test_WithBatchSize(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		opts := &Options{BatchSize: 100}
+
+		// --- When ---
+		WithBatchSize(10)(opts)
+
+		// --- Then ---
+		assert.Equal(t, 10, opts.BatchSize)
+	})
+
+	t.Run("less than one is ignored", func(t *testing.T) {
+		// --- Given ---
+		opts := &Options{BatchSize: 100}
+
+		// --- When ---
+		WithBatchSize(0)(opts)
+
+		// --- Then ---
+		assert.Equal(t, 100, opts.BatchSize)
+	})
+}
+
+func Test_WithTagOptions(t *testing.T) {
+	// --- Given ---
+	opts := &Options{}
+
+	// --- When ---
+	WithTagOptions(nomix.WithLen(1), nomix.WithLen(2))(opts)
+
+	// --- Then ---
+	assert.Len(t, 2, opts.TagOptions)
+}
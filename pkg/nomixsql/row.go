@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomixsql
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+)
+
+// Row represents a single row of the tag table.
+type Row struct {
+	Owner string     // Owner ID.
+	Name  string     // Tag name.
+	Kind  nomix.Kind // Tag kind.
+	Idx   int        // Element index, -1 for empty slices.
+	Value any        // Value for the column matching the tag kind, or nil.
+}
+
+// Args returns the query arguments for all table columns.
+func (r Row) Args() ([]any, error) {
+	args := make([]any, len(columns))
+	args[0] = r.Owner
+	args[1] = r.Name
+	args[2] = int64(r.Kind)
+	args[3] = int64(r.Idx)
+	if r.Value == nil {
+		return args, nil
+	}
+	col, err := Column(r.Kind)
+	if err != nil {
+		return nil, err
+	}
+	args[4+slices.Index(valueColumns, col)] = r.Value
+	return args, nil
+}
+
+// TagRows returns the table rows representing the tag. Returns an error if
+// the tag kind cannot be stored, or the tag value doesn't match its kind.
+func TagRows(owner string, tag nomix.Tag) ([]Row, error) {
+	name := tag.TagName()
+	knd := tag.TagKind()
+	if _, err := Column(knd); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	val := reflect.ValueOf(tag.TagValue())
+	if !knd.IsSlice() || knd.Base() == kindByte {
+		v, err := columnValue(knd, val)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		return []Row{{Owner: owner, Name: name, Kind: knd, Value: v}}, nil
+	}
+
+	if val.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("%s: %w", name, nomix.ErrInvType)
+	}
+	if val.Len() == 0 {
+		return []Row{{Owner: owner, Name: name, Kind: knd, Idx: -1}}, nil
+	}
+	rows := make([]Row, val.Len())
+	for i := range val.Len() {
+		v, err := columnValue(knd, val.Index(i))
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d]: %w", name, i, err)
+		}
+		rows[i] = Row{Owner: owner, Name: name, Kind: knd, Idx: i, Value: v}
+	}
+	return rows, nil
+}
+
+// columnValue converts the single value, or the slice element, to the value
+// stored in the column for the base of the kind. Returns
+// [nomix.ErrInvType] if the value cannot be converted.
+//
+// nolint: cyclop
+func columnValue(knd nomix.Kind, val reflect.Value) (any, error) {
+	switch knd.Base() {
+	case nomix.KindInt64:
+		switch val.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+			reflect.Int64:
+			return val.Int(), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+			reflect.Uint64:
+			if val.Uint() > math.MaxInt64 {
+				return nil, nomix.ErrInvValue
+			}
+			return int64(val.Uint()), nil
+		case reflect.Bool:
+			if val.Bool() {
+				return int64(1), nil
+			}
+			return int64(0), nil
+		}
+
+	case nomix.KindFloat64:
+		if val.CanFloat() {
+			return val.Float(), nil
+		}
+
+	case nomix.KindString:
+		if val.Kind() == reflect.String {
+			return val.String(), nil
+		}
+
+	case nomix.KindTime:
+		if v, ok := val.Interface().(time.Time); ok {
+			return v, nil
+		}
+
+	case nomix.KindUUID:
+		if v, ok := val.Interface().([16]byte); ok {
+			return xtag.FormatUUID(v), nil
+		}
+		if val.Kind() == reflect.String {
+			return val.String(), nil
+		}
+
+	case nomix.KindJSON:
+		if v, ok := val.Interface().([]byte); ok {
+			return string(v), nil
+		}
+		if v, ok := val.Interface().(json.RawMessage); ok {
+			return string(v), nil
+		}
+		if val.Kind() == reflect.String {
+			return val.String(), nil
+		}
+
+	case kindByte:
+		if v, ok := val.Interface().([]byte); ok {
+			// Empty bytes must not be stored as NULL.
+			return append([]byte{}, v...), nil
+		}
+	}
+	return nil, nomix.ErrInvType
+}
+
+// RowsTag creates a [nomix.Tag] from its table rows using the
+// [nomix.KindSpec] registered in the registry for the tag kind. The rows must
+// belong to the same tag and be sorted by the element index. The options are
+// passed to [nomix.KindSpec.TagParse].
+func RowsTag(
+	reg *nomix.Registry,
+	rows []Row,
+	opts ...nomix.Option,
+) (nomix.Tag, error) {
+
+	if len(rows) == 0 {
+		return nil, nomix.ErrMissing
+	}
+	name := rows[0].Name
+	knd := rows[0].Kind
+	spec := reg.SpecForKind(knd)
+	if spec.IsZero() {
+		const format = "%s: %w for %[3]s(%[3]d)"
+		return nil, fmt.Errorf(format, name, nomix.ErrNoSpec, knd)
+	}
+
+	var str string
+	if !knd.IsSlice() || knd.Base() == kindByte {
+		if len(rows) != 1 {
+			return nil, fmt.Errorf("%s: %w", name, nomix.ErrInvFormat)
+		}
+		var err error
+		if str, err = columnText(knd, rows[0].Value); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	} else {
+		elems := make([]string, 0, len(rows))
+		for i, row := range rows {
+			if row.Idx == -1 && len(rows) == 1 {
+				break
+			}
+			if row.Idx != i || row.Name != name || row.Kind != knd {
+				return nil, fmt.Errorf("%s: %w", name, nomix.ErrInvFormat)
+			}
+			elem, err := columnText(knd, row.Value)
+			if err != nil {
+				return nil, fmt.Errorf("%s[%d]: %w", name, i, err)
+			}
+			elems = append(elems, elem)
+		}
+		quote := knd.Base() != nomix.KindInt64 &&
+			knd.Base() != nomix.KindFloat64
+		str, _ = nomix.JSONArray{}.SliceEncode(elems, quote)
+	}
+	if knd == nomix.KindJSON && str == "" {
+		// The empty document is stored for JSON tags without a value.
+		return xtag.NewJSON(name, nil), nil
+	}
+	return spec.TagParse(name, str, opts...)
+}
+
+// columnText converts the value read from the column for the base of the
+// kind to its string representation, as expected by the tag parsers. The
+// NULL value represents the empty value of byte slice and JSON kinds.
+// Returns [nomix.ErrMissing] for the NULL value of other kinds, and
+// [nomix.ErrInvType] if the value's type is not supported.
+//
+// nolint: cyclop
+func columnText(knd nomix.Kind, val any) (string, error) {
+	if val == nil {
+		switch {
+		case knd.Base() == kindByte:
+			return byteText(nil), nil
+		case knd == nomix.KindJSON:
+			return "", nil
+		}
+		return "", nomix.ErrMissing
+	}
+	switch v := val.(type) {
+	case int64:
+		if knd.Base() == nomix.KindInt64 || knd.Base() == nomix.KindFloat64 {
+			return strconv.FormatInt(v, 10), nil
+		}
+	case float64:
+		if knd.Base() == nomix.KindFloat64 {
+			return strconv.FormatFloat(v, 'g', -1, 64), nil
+		}
+	case time.Time:
+		if knd.Base() == nomix.KindTime {
+			return v.Format(time.RFC3339Nano), nil
+		}
+	case string:
+		if knd.Base() == kindByte {
+			return byteText([]byte(v)), nil
+		}
+		return v, nil
+	case []byte:
+		if knd.Base() == kindByte {
+			return byteText(v), nil
+		}
+		return string(v), nil
+	}
+	return "", nomix.ErrInvType
+}
+
+// byteText returns the representation of bytes as expected by
+// [xtag.ParseByteSlice].
+func byteText(v []byte) string {
+	buf := make([]byte, 0, len(v)*4+2)
+	buf = append(buf, '[')
+	for i, b := range v {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = strconv.AppendInt(buf, int64(b), 10)
+	}
+	return string(append(buf, ']'))
+}
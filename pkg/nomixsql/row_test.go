@@ -0,0 +1,501 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomixsql
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+)
+
+// tstUUID is the UUID used in tests.
+var tstUUID = [16]byte{
+	0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1,
+	0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8,
+}
+
+// tstUUIDStr is the string representation of tstUUID.
+const tstUUIDStr = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+// tstRegistry returns a registry with all xtag kinds registered.
+func tstRegistry() *nomix.Registry {
+	reg := nomix.NewRegistry()
+	xtag.RegisterAll(reg)
+	return reg
+}
+
+func Test_Row_Args(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		// --- Given ---
+		row := Row{Owner: "o", Name: "A", Kind: nomix.KindFloat64, Value: 1.5}
+
+		// --- When ---
+		have, err := row.Args()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := []any{
+			"o", "A", int64(8), int64(0),
+			nil, nil, 1.5, nil, nil, nil, nil,
+		}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("no value", func(t *testing.T) {
+		// --- Given ---
+		row := Row{Owner: "o", Name: "A", Kind: nomix.KindIntSlice, Idx: -1}
+
+		// --- When ---
+		have, err := row.Args()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := []any{
+			"o", "A", int64(nomix.KindIntSlice), int64(-1),
+			nil, nil, nil, nil, nil, nil, nil,
+		}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("error - unsupported kind", func(t *testing.T) {
+		// --- Given ---
+		row := Row{Owner: "o", Name: "A", Kind: 0x7F, Value: 1}
+
+		// --- When ---
+		have, err := row.Args()
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_TagRows(t *testing.T) {
+	t.Run("single", func(t *testing.T) {
+		// --- Given ---
+		tag := xtag.NewInt("A", 42)
+
+		// --- When ---
+		have, err := TagRows("o", tag)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := []Row{
+			{Owner: "o", Name: "A", Kind: nomix.KindInt, Value: int64(42)},
+		}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		// --- Given ---
+		tag := xtag.NewStringSlice("A", "a", "b")
+
+		// --- When ---
+		have, err := TagRows("o", tag)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		knd := nomix.KindStringSlice
+		exp := []Row{
+			{Owner: "o", Name: "A", Kind: knd, Idx: 0, Value: "a"},
+			{Owner: "o", Name: "A", Kind: knd, Idx: 1, Value: "b"},
+		}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		// --- Given ---
+		tag := xtag.NewFloat64Slice("A")
+
+		// --- When ---
+		have, err := TagRows("o", tag)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		knd := nomix.KindFloat64Slice
+		exp := []Row{{Owner: "o", Name: "A", Kind: knd, Idx: -1}}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("byte slice", func(t *testing.T) {
+		// --- Given ---
+		tag := xtag.NewByteSlice("A", 1, 2)
+
+		// --- When ---
+		have, err := TagRows("o", tag)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		knd := nomix.KindByteSlice
+		exp := []Row{{Owner: "o", Name: "A", Kind: knd, Value: []byte{1, 2}}}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("error - unsupported kind", func(t *testing.T) {
+		// --- Given ---
+		tag := nomix.NewSingle("A", 1, 0x7F, nil, nil, nil)
+
+		// --- When ---
+		have, err := TagRows("o", tag)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.ErrorContain(t, "A: ", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid value type", func(t *testing.T) {
+		// --- Given ---
+		tag := nomix.NewSingle("A", "abc", nomix.KindInt64, nil, nil, nil)
+
+		// --- When ---
+		have, err := TagRows("o", tag)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "A: invalid element type", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid element type", func(t *testing.T) {
+		// --- Given ---
+		knd := nomix.KindInt64Slice
+		tag := nomix.NewSlice("A", []string{"a"}, knd, nil, nil, nil)
+
+		// --- When ---
+		have, err := TagRows("o", tag)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "A[0]: invalid element type", err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_columnValue_tabular(t *testing.T) {
+	tim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tt := []struct {
+		testN string
+
+		knd nomix.Kind
+		val any
+		exp any
+	}{
+		{"int", nomix.KindInt, 42, int64(42)},
+		{"int8", nomix.KindInt64, int8(42), int64(42)},
+		{"uint", nomix.KindInt64, uint(42), int64(42)},
+		{"bool true", nomix.KindBool, true, int64(1)},
+		{"bool false", nomix.KindBool, false, int64(0)},
+		{"float64", nomix.KindFloat64, 1.5, 1.5},
+		{"float32", nomix.KindFloat64, float32(1.5), 1.5},
+		{"string", nomix.KindString, "abc", "abc"},
+		{"time", nomix.KindTime, tim, tim},
+		{"uuid", nomix.KindUUID, tstUUID, tstUUIDStr},
+		{"uuid string", nomix.KindUUID, tstUUIDStr, tstUUIDStr},
+		{"json", nomix.KindJSON, []byte(`{"A":1}`), `{"A":1}`},
+		{"json raw", nomix.KindJSON, json.RawMessage(`1`), `1`},
+		{"json string", nomix.KindJSON, `[1]`, `[1]`},
+		{"bytes", nomix.KindByteSlice, []byte{1, 2}, []byte{1, 2}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := columnValue(tc.knd, reflect.ValueOf(tc.val))
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_columnValue(t *testing.T) {
+	t.Run("bytes are copied", func(t *testing.T) {
+		// --- Given ---
+		val := []byte{1, 2}
+
+		// --- When ---
+		have, err := columnValue(nomix.KindByteSlice, reflect.ValueOf(val))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		val[0] = 9
+		assert.Equal(t, []byte{1, 2}, have)
+	})
+
+	t.Run("nil bytes are not null", func(t *testing.T) {
+		// --- Given ---
+		val := reflect.ValueOf([]byte(nil))
+
+		// --- When ---
+		have, err := columnValue(nomix.KindByteSlice, val)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.NotNil(t, have)
+		assert.Equal(t, []byte{}, have)
+	})
+
+	t.Run("error - uint overflow", func(t *testing.T) {
+		// --- Given ---
+		val := reflect.ValueOf(uint64(1 << 63))
+
+		// --- When ---
+		have, err := columnValue(nomix.KindInt64, val)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvValue, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := columnValue(nomix.KindTime, reflect.ValueOf("abc"))
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_RowsTag(t *testing.T) {
+	t.Run("single", func(t *testing.T) {
+		// --- Given ---
+		rows := []Row{{Name: "A", Kind: nomix.KindBool, Value: int64(1)}}
+
+		// --- When ---
+		have, err := RowsTag(tstRegistry(), rows)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.SameType(t, &xtag.Bool{}, have)
+		assert.Equal(t, "A", have.TagName())
+		assert.Equal(t, true, have.TagValue())
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		// --- Given ---
+		knd := nomix.KindStringSlice
+		rows := []Row{
+			{Name: "A", Kind: knd, Idx: 0, Value: `a"b`},
+			{Name: "A", Kind: knd, Idx: 1, Value: []byte("c,d")},
+		}
+
+		// --- When ---
+		have, err := RowsTag(tstRegistry(), rows)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.SameType(t, &xtag.StringSlice{}, have)
+		assert.Equal(t, []string{`a"b`, "c,d"}, have.TagValue())
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		// --- Given ---
+		rows := []Row{{Name: "A", Kind: nomix.KindIntSlice, Idx: -1}}
+
+		// --- When ---
+		have, err := RowsTag(tstRegistry(), rows)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []int{}, have.TagValue())
+	})
+
+	t.Run("byte slice", func(t *testing.T) {
+		// --- Given ---
+		knd := nomix.KindByteSlice
+		rows := []Row{{Name: "A", Kind: knd, Value: []byte{0, 255}}}
+
+		// --- When ---
+		have, err := RowsTag(tstRegistry(), rows)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.SameType(t, &xtag.ByteSlice{}, have)
+		assert.Equal(t, []byte{0, 255}, have.TagValue())
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- Given ---
+		rows := []Row{{Name: "A", Kind: nomix.KindTime, Value: "2000"}}
+
+		// --- When ---
+		have, err := RowsTag(tstRegistry(), rows, nomix.WithTimeFormat("2006"))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, exp, have.TagValue())
+	})
+
+	t.Run("error - no rows", func(t *testing.T) {
+		// --- When ---
+		have, err := RowsTag(tstRegistry(), nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrMissing, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - kind not registered", func(t *testing.T) {
+		// --- Given ---
+		rows := []Row{{Name: "A", Kind: nomix.KindInt64, Value: int64(1)}}
+
+		// --- When ---
+		have, err := RowsTag(nomix.NewRegistry(), rows)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrNoSpec, err)
+		assert.ErrorEqual(t, "A: spec not found for KindInt64(4)", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - many rows for single kind", func(t *testing.T) {
+		// --- Given ---
+		rows := []Row{
+			{Name: "A", Kind: nomix.KindInt64, Value: int64(1)},
+			{Name: "A", Kind: nomix.KindInt64, Idx: 1, Value: int64(2)},
+		}
+
+		// --- When ---
+		have, err := RowsTag(tstRegistry(), rows)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - missing value", func(t *testing.T) {
+		// --- Given ---
+		rows := []Row{{Name: "A", Kind: nomix.KindInt64}}
+
+		// --- When ---
+		have, err := RowsTag(tstRegistry(), rows)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrMissing, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - slice index gap", func(t *testing.T) {
+		// --- Given ---
+		knd := nomix.KindInt64Slice
+		rows := []Row{
+			{Name: "A", Kind: knd, Idx: 0, Value: int64(1)},
+			{Name: "A", Kind: knd, Idx: 2, Value: int64(2)},
+		}
+
+		// --- When ---
+		have, err := RowsTag(tstRegistry(), rows)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - slice element", func(t *testing.T) {
+		// --- Given ---
+		knd := nomix.KindInt64Slice
+		rows := []Row{{Name: "A", Kind: knd, Idx: 0, Value: 1.5}}
+
+		// --- When ---
+		have, err := RowsTag(tstRegistry(), rows)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "A[0]: invalid element type", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - parsing", func(t *testing.T) {
+		// --- Given ---
+		rows := []Row{{Name: "A", Kind: nomix.KindUUID, Value: "abc"}}
+
+		// --- When ---
+		have, err := RowsTag(tstRegistry(), rows)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_columnText_tabular(t *testing.T) {
+	tim := time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	tt := []struct {
+		testN string
+
+		knd nomix.Kind
+		val any
+		exp string
+	}{
+		{"int64", nomix.KindInt64, int64(-42), "-42"},
+		{"int64 as float", nomix.KindFloat64, int64(42), "42"},
+		{"float64", nomix.KindFloat64, 1.5, "1.5"},
+		{"float64 exponent", nomix.KindFloat64, 1e21, "1e+21"},
+		{"time", nomix.KindTime, tim, "2000-01-02T03:04:05.000000006Z"},
+		{"string", nomix.KindString, "abc", "abc"},
+		{"bytes as string", nomix.KindString, []byte("abc"), "abc"},
+		{"byte slice", nomix.KindByteSlice, []byte{0, 255}, "[0,255]"},
+		{"byte slice string", nomix.KindByteSlice, "AB", "[65,66]"},
+		{"byte slice null", nomix.KindByteSlice, nil, "[]"},
+		{"json null", nomix.KindJSON, nil, ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := columnText(tc.knd, tc.val)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_columnText(t *testing.T) {
+	t.Run("error - nil", func(t *testing.T) {
+		// --- When ---
+		have, err := columnText(nomix.KindInt64, nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrMissing, err)
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := columnText(nomix.KindTime, int64(1))
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Equal(t, "", have)
+	})
+}
+
+func Test_byteText(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		// --- When ---
+		have := byteText(nil)
+
+		// --- Then ---
+		assert.Equal(t, "[]", have)
+	})
+
+	t.Run("not empty", func(t *testing.T) {
+		// --- When ---
+		have := byteText([]byte{1, 20, 255})
+
+		// --- Then ---
+		assert.Equal(t, "[1,20,255]", have)
+	})
+}
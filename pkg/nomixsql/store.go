@@ -0,0 +1,344 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomixsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+// DBTX represents the database handle used by [Store]. Both [sql.DB] and
+// [sql.Tx] implement it.
+type DBTX interface {
+	// ExecContext executes a query without returning any rows.
+	ExecContext(
+		ctx context.Context,
+		query string,
+		args ...any,
+	) (sql.Result, error)
+
+	// QueryContext executes a query that returns rows.
+	QueryContext(
+		ctx context.Context,
+		query string,
+		args ...any,
+	) (*sql.Rows, error)
+}
+
+// Store persists tag sets in the tag table.
+//
+// The table name is used in queries as-is, it is not quoted or escaped.
+type Store struct {
+	reg  *nomix.Registry // Registry used to create loaded tags.
+	opts Options         // Store options.
+}
+
+// NewStore returns a new instance of [Store]. The registry is used to create
+// tags when loading them, it must have [nomix.KindSpec] registered for all
+// stored tag kinds.
+func NewStore(reg *nomix.Registry, opts ...Option) *Store {
+	return &Store{reg: reg, opts: NewOptions(opts...)}
+}
+
+// Load loads tags of the owner. Returns an empty set if the owner has no
+// tags.
+func (s *Store) Load(
+	ctx context.Context,
+	db DBTX,
+	owner string,
+) (nomix.TagSet, error) {
+
+	sets, err := s.LoadMany(ctx, db, owner)
+	if err != nil {
+		return nomix.TagSet{}, err
+	}
+	return sets[owner], nil
+}
+
+// LoadMany loads tags of many owners using queries for at most
+// [Options.BatchSize] owners each. The returned map has a set, possibly
+// empty, for each of the owners.
+func (s *Store) LoadMany(
+	ctx context.Context,
+	db DBTX,
+	owners ...string,
+) (map[string]nomix.TagSet, error) {
+
+	sets := make(map[string]nomix.TagSet, len(owners))
+	for _, owner := range owners {
+		sets[owner] = nomix.NewTagSet()
+	}
+	for batch := range slices.Chunk(owners, s.opts.BatchSize) {
+		if err := s.load(ctx, db, batch, sets); err != nil {
+			return nil, err
+		}
+	}
+	return sets, nil
+}
+
+// load loads tags of the owners and adds them to the sets.
+func (s *Store) load(
+	ctx context.Context,
+	db DBTX,
+	owners []string,
+	sets map[string]nomix.TagSet,
+) error {
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM %s WHERE %s IN (%s) ORDER BY %s, %s, %s",
+		strings.Join(columns, ", "),
+		s.opts.Table,
+		ColOwner,
+		s.placeholders(1, len(owners)),
+		ColOwner,
+		ColName,
+		ColIdx,
+	)
+	args := make([]any, len(owners))
+	for i, owner := range owners {
+		args[i] = owner
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var tag []Row
+	for rows.Next() {
+		row, err := scanRow(rows)
+		if err != nil {
+			return err
+		}
+		if len(tag) > 0 &&
+			(tag[0].Owner != row.Owner || tag[0].Name != row.Name) {
+			if err = s.add(sets, tag); err != nil {
+				return err
+			}
+			tag = tag[:0]
+		}
+		tag = append(tag, row)
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	if len(tag) > 0 {
+		return s.add(sets, tag)
+	}
+	return nil
+}
+
+// add creates the tag from its rows and adds it to the owner's set.
+func (s *Store) add(sets map[string]nomix.TagSet, rows []Row) error {
+	owner := rows[0].Owner
+	tag, err := RowsTag(s.reg, rows, s.opts.TagOptions...)
+	if err != nil {
+		return fmt.Errorf("%s: %w", owner, err)
+	}
+	set, ok := sets[owner]
+	if !ok {
+		set = nomix.NewTagSet()
+		sets[owner] = set
+	}
+	set.TagSet(tag)
+	return nil
+}
+
+// scanRow scans the current row of the query result.
+func scanRow(rows *sql.Rows) (Row, error) {
+	var row Row
+	var knd, idx int64
+	vals := make([]any, len(valueColumns))
+	dst := []any{&row.Owner, &row.Name, &knd, &idx}
+	for i := range vals {
+		dst = append(dst, &vals[i])
+	}
+	if err := rows.Scan(dst...); err != nil {
+		return Row{}, err
+	}
+	row.Kind = nomix.Kind(knd)
+	row.Idx = int(idx)
+	col, err := Column(row.Kind)
+	if err != nil {
+		return Row{}, fmt.Errorf("%s: %s: %w", row.Owner, row.Name, err)
+	}
+	row.Value = vals[slices.Index(valueColumns, col)]
+	return row, nil
+}
+
+// Save replaces all tags of the owner with the tags in the set.
+//
+// When db is [sql.DB], the changes are made in a transaction.
+func (s *Store) Save(
+	ctx context.Context,
+	db DBTX,
+	owner string,
+	set nomix.TagSet,
+) error {
+
+	return s.SaveMany(ctx, db, map[string]nomix.TagSet{owner: set})
+}
+
+// SaveMany replaces all tags of many owners with the tags in their sets.
+// Rows are inserted using statements with at most [Options.BatchSize] rows
+// each. Returns an error, without making any changes, if any of the tags
+// cannot be stored.
+//
+// When db is [sql.DB], the changes are made in a transaction.
+func (s *Store) SaveMany(
+	ctx context.Context,
+	db DBTX,
+	sets map[string]nomix.TagSet,
+) error {
+
+	owners := slices.Sorted(maps.Keys(sets))
+	var rows []Row
+	for _, owner := range owners {
+		tags := sets[owner].TagGetAll()
+		for _, name := range slices.Sorted(maps.Keys(tags)) {
+			tr, err := TagRows(owner, tags[name])
+			if err != nil {
+				return fmt.Errorf("%s: %w", owner, err)
+			}
+			rows = append(rows, tr...)
+		}
+	}
+
+	if sdb, ok := db.(*sql.DB); ok {
+		return Tx(ctx, sdb, func(tx *sql.Tx) error {
+			return s.save(ctx, tx, owners, rows)
+		})
+	}
+	return s.save(ctx, db, owners, rows)
+}
+
+// save deletes all tags of the owners and inserts the rows.
+func (s *Store) save(
+	ctx context.Context,
+	db DBTX,
+	owners []string,
+	rows []Row,
+) error {
+
+	for batch := range slices.Chunk(owners, s.opts.BatchSize) {
+		if err := s.delete(ctx, db, batch, nil); err != nil {
+			return err
+		}
+	}
+	for batch := range slices.Chunk(rows, s.opts.BatchSize) {
+		if err := s.insert(ctx, db, batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insert inserts the rows with a single statement.
+func (s *Store) insert(ctx context.Context, db DBTX, rows []Row) error {
+	buf := &strings.Builder{}
+	buf.WriteString("INSERT INTO ")
+	buf.WriteString(s.opts.Table)
+	buf.WriteString(" (")
+	buf.WriteString(strings.Join(columns, ", "))
+	buf.WriteString(") VALUES ")
+
+	args := make([]any, 0, len(rows)*len(columns))
+	for i, row := range rows {
+		vals, err := row.Args()
+		if err != nil {
+			return fmt.Errorf("%s: %s: %w", row.Owner, row.Name, err)
+		}
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString("(")
+		buf.WriteString(s.placeholders(len(args)+1, len(vals)))
+		buf.WriteString(")")
+		args = append(args, vals...)
+	}
+	_, err := db.ExecContext(ctx, buf.String(), args...)
+	return err
+}
+
+// Delete deletes the named tags of the owner. When no names are given, all
+// tags of the owner are deleted.
+func (s *Store) Delete(
+	ctx context.Context,
+	db DBTX,
+	owner string,
+	names ...string,
+) error {
+
+	return s.delete(ctx, db, []string{owner}, names)
+}
+
+// delete deletes the named tags of the owners. When no names are given, all
+// tags of the owners are deleted.
+func (s *Store) delete(
+	ctx context.Context,
+	db DBTX,
+	owners []string,
+	names []string,
+) error {
+
+	query := fmt.Sprintf(
+		"DELETE FROM %s WHERE %s IN (%s)",
+		s.opts.Table,
+		ColOwner,
+		s.placeholders(1, len(owners)),
+	)
+	args := make([]any, 0, len(owners)+len(names))
+	for _, owner := range owners {
+		args = append(args, owner)
+	}
+	if len(names) > 0 {
+		query += fmt.Sprintf(
+			" AND %s IN (%s)",
+			ColName,
+			s.placeholders(len(args)+1, len(names)),
+		)
+		for _, name := range names {
+			args = append(args, name)
+		}
+	}
+	_, err := db.ExecContext(ctx, query, args...)
+	return err
+}
+
+// placeholders returns a comma separated list of n query parameter
+// placeholders starting with the given parameter number.
+func (s *Store) placeholders(start, n int) string {
+	buf := &strings.Builder{}
+	for i := range n {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(s.opts.Dialect.Placeholder(start + i))
+	}
+	return buf.String()
+}
+
+// Tx executes fn in a transaction. The transaction is committed when fn
+// returns nil, and rolled back otherwise.
+func Tx(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err = fn(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Join(err, rbErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
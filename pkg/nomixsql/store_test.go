@@ -0,0 +1,591 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomixsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+)
+
+// tstTagSet returns a tag set with tags of all xtag kinds.
+func tstTagSet() nomix.TagSet {
+	tim := time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC)
+	set := nomix.NewTagSet()
+	set.TagSet(
+		xtag.NewString("str", "abc"),
+		xtag.NewInt64("int64", -42),
+		xtag.NewInt("int", 42),
+		xtag.NewBool("bool", true),
+		xtag.NewFloat64("float64", 1.5),
+		xtag.NewTime("time", tim),
+		xtag.NewJSON("json", json.RawMessage(`{"A":1}`)),
+		xtag.NewUUID("uuid", tstUUID),
+		xtag.NewByteSlice("bytes", 0, 1, 255),
+		xtag.NewStringSlice("strs", `a"b`, "c,d", ""),
+		xtag.NewInt64Slice("int64s", 1, -2),
+		xtag.NewIntSlice("ints"),
+		xtag.NewBoolSlice("bools", true, false),
+		xtag.NewFloat64Slice("float64s", 1.5, 2),
+		xtag.NewTimeSlice("times", tim, tim.Add(time.Hour)),
+		xtag.NewUUIDSlice("uuids", tstUUID, [16]byte{}),
+	)
+	return set
+}
+
+func Test_NewStore(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		// --- Given ---
+		reg := tstRegistry()
+
+		// --- When ---
+		have := NewStore(reg)
+
+		// --- Then ---
+		assert.Same(t, reg, have.reg)
+		assert.Equal(t, NewOptions(), have.opts)
+		assert.Fields(t, 2, have)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- When ---
+		have := NewStore(tstRegistry(), WithTable("meta"))
+
+		// --- Then ---
+		assert.Equal(t, "meta", have.opts.Table)
+	})
+}
+
+func Test_Store_Save(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		db, _ := tstDB(t)
+		set := tstTagSet()
+		str := NewStore(tstRegistry())
+
+		// --- When ---
+		err := str.Save(context.Background(), db, "o", set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		have, err := str.Load(context.Background(), db, "o")
+		assert.NoError(t, err)
+		assert.Equal(t, set.MetaGetAll(), have.MetaGetAll())
+		for name, tag := range set.TagGetAll() {
+			assert.Equal(t, tag.TagKind(), have.TagGet(name).TagKind())
+		}
+	})
+
+	t.Run("round trip empty bytes and json", func(t *testing.T) {
+		// --- Given ---
+		db, _ := tstDB(t)
+		set := nomix.NewTagSet()
+		set.TagSet(xtag.NewByteSlice("bytes"), xtag.NewJSON("json", nil))
+		str := NewStore(tstRegistry())
+
+		// --- When ---
+		err := str.Save(context.Background(), db, "o", set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		have, err := str.Load(context.Background(), db, "o")
+		assert.NoError(t, err)
+		assert.Len(t, 0, have.TagGet("bytes").TagValue())
+		assert.Equal(t, nomix.KindByteSlice, have.TagGet("bytes").TagKind())
+		assert.Len(t, 0, have.TagGet("json").TagValue())
+		assert.Equal(t, nomix.KindJSON, have.TagGet("json").TagKind())
+	})
+
+	t.Run("replaces all owner tags", func(t *testing.T) {
+		// --- Given ---
+		db, _ := tstDB(t)
+		str := NewStore(tstRegistry())
+		ctx := context.Background()
+
+		set0 := nomix.NewTagSet()
+		set0.TagSet(xtag.NewInt("A", 1), xtag.NewInt("B", 2))
+		assert.NoError(t, str.Save(ctx, db, "o", set0))
+		assert.NoError(t, str.Save(ctx, db, "x", set0))
+
+		set1 := nomix.NewTagSet()
+		set1.TagSet(xtag.NewString("C", "c"))
+
+		// --- When ---
+		err := str.Save(ctx, db, "o", set1)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		have, err := str.LoadMany(ctx, db, "o", "x")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"C": "c"}, have["o"].MetaGetAll())
+		exp := map[string]any{"A": 1, "B": 2}
+		assert.Equal(t, exp, have["x"].MetaGetAll())
+	})
+
+	t.Run("in transaction", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		str := NewStore(tstRegistry())
+
+		// --- When ---
+		err := str.Save(context.Background(), db, "o", tstTagSet())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 1, fdb.commits)
+		assert.Equal(t, 0, fdb.backs)
+	})
+
+	t.Run("caller transaction", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		str := NewStore(tstRegistry())
+		ctx := context.Background()
+
+		// --- When ---
+		err := Tx(ctx, db, func(tx *sql.Tx) error {
+			if err := str.Save(ctx, tx, "o", tstTagSet()); err != nil {
+				return err
+			}
+			return str.Save(ctx, tx, "x", tstTagSet())
+		})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 1, fdb.commits)
+	})
+
+	t.Run("batches", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		str := NewStore(tstRegistry(), WithBatchSize(3))
+		set := nomix.NewTagSet()
+		set.TagSet(xtag.NewInt64Slice("A", 1, 2, 3, 4), xtag.NewInt("B", 5))
+
+		// --- When ---
+		err := str.Save(context.Background(), db, "o", set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 5, fdb.Rows())
+		inserts := fdb.Queries("INSERT")
+		assert.Len(t, 2, inserts)
+		exp := "INSERT INTO tags (owner_id, name, kind, idx, val_string, " +
+			"val_int64, val_float64, val_time, val_json, val_uuid, " +
+			"val_bytes) VALUES " +
+			"($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11), " +
+			"($12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)"
+		assert.Equal(t, exp, inserts[1])
+	})
+
+	t.Run("stored rows", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		str := NewStore(tstRegistry())
+		set := nomix.NewTagSet()
+		set.TagSet(xtag.NewBoolSlice("A", true), xtag.NewFloat64Slice("B"))
+
+		// --- When ---
+		err := str.Save(context.Background(), db, "o", set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := [][]driver.Value{
+			{
+				"o", "A", int64(nomix.KindBoolSlice), int64(0),
+				nil, int64(1), nil, nil, nil, nil, nil,
+			},
+			{
+				"o", "B", int64(nomix.KindFloat64Slice), int64(-1),
+				nil, nil, nil, nil, nil, nil, nil,
+			},
+		}
+		assert.Equal(t, exp, fdb.Rows())
+	})
+
+	t.Run("dialect placeholders", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		str := NewStore(tstRegistry(), WithDialect(MySQL), WithTable("meta"))
+		set := nomix.NewTagSet()
+		set.TagSet(xtag.NewInt("A", 1))
+
+		// --- When ---
+		err := str.Save(context.Background(), db, "o", set)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := []string{"DELETE FROM meta WHERE owner_id IN (?)"}
+		assert.Equal(t, exp, fdb.Queries("DELETE"))
+		exp = []string{
+			"INSERT INTO meta (owner_id, name, kind, idx, val_string, " +
+				"val_int64, val_float64, val_time, val_json, val_uuid, " +
+				"val_bytes) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		}
+		assert.Equal(t, exp, fdb.Queries("INSERT"))
+	})
+
+	t.Run("error - tag cannot be stored", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		str := NewStore(tstRegistry())
+		set := nomix.NewTagSet()
+		set.TagSet(nomix.NewSingle("A", 1, 0x7F, nil, nil, nil))
+
+		// --- When ---
+		err := str.Save(context.Background(), db, "o", set)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.ErrorContain(t, "o: A: ", err)
+		assert.Nil(t, fdb.Queries(""))
+	})
+
+	t.Run("error - rolled back", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		str := NewStore(tstRegistry(), WithBatchSize(2))
+		set := nomix.NewTagSet()
+		set.TagSet(xtag.NewInt("A", 1))
+		assert.NoError(t, str.Save(context.Background(), db, "o", set))
+		fdb.fail = "INSERT"
+
+		// --- When ---
+		err := str.Save(context.Background(), db, "o", tstTagSet())
+
+		// --- Then ---
+		assert.ErrorIs(t, errFake, err)
+		assert.Equal(t, 1, fdb.backs)
+		have, err := str.Load(context.Background(), db, "o")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"A": 1}, have.MetaGetAll())
+	})
+
+	t.Run("error - delete", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		fdb.fail = "DELETE"
+		str := NewStore(tstRegistry())
+
+		// --- When ---
+		err := str.Save(context.Background(), db, "o", tstTagSet())
+
+		// --- Then ---
+		assert.ErrorIs(t, errFake, err)
+		assert.Nil(t, fdb.Queries("INSERT"))
+	})
+}
+
+func Test_Store_SaveMany(t *testing.T) {
+	t.Run("save", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		str := NewStore(tstRegistry(), WithBatchSize(2))
+		ctx := context.Background()
+		sets := map[string]nomix.TagSet{
+			"o": nomix.NewTagSet(),
+			"x": nomix.NewTagSet(),
+			"z": nomix.NewTagSet(),
+		}
+		sets["o"].TagSet(xtag.NewInt("A", 1))
+		sets["x"].TagSet(xtag.NewString("B", "b"))
+
+		// --- When ---
+		err := str.SaveMany(ctx, db, sets)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := []string{
+			"DELETE FROM tags WHERE owner_id IN ($1, $2)",
+			"DELETE FROM tags WHERE owner_id IN ($1)",
+		}
+		assert.Equal(t, exp, fdb.Queries("DELETE"))
+		have, err := str.LoadMany(ctx, db, "o", "x", "z")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"A": 1}, have["o"].MetaGetAll())
+		assert.Equal(t, map[string]any{"B": "b"}, have["x"].MetaGetAll())
+		assert.Equal(t, 0, have["z"].TagCount())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		str := NewStore(tstRegistry())
+
+		// --- When ---
+		err := str.SaveMany(context.Background(), db, nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, fdb.Queries("DELETE"))
+		assert.Nil(t, fdb.Queries("INSERT"))
+	})
+}
+
+func Test_Store_Load(t *testing.T) {
+	t.Run("no tags", func(t *testing.T) {
+		// --- Given ---
+		db, _ := tstDB(t)
+		str := NewStore(tstRegistry())
+
+		// --- When ---
+		have, err := str.Load(context.Background(), db, "o")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 0, have.TagCount())
+	})
+
+	t.Run("query", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		str := NewStore(tstRegistry())
+
+		// --- When ---
+		_, err := str.Load(context.Background(), db, "o")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := []string{
+			"SELECT owner_id, name, kind, idx, val_string, val_int64, " +
+				"val_float64, val_time, val_json, val_uuid, val_bytes " +
+				"FROM tags WHERE owner_id IN ($1) " +
+				"ORDER BY owner_id, name, idx",
+		}
+		assert.Equal(t, exp, fdb.Queries("SELECT"))
+	})
+
+	t.Run("with tag options", func(t *testing.T) {
+		// --- Given ---
+		db, _ := tstDB(t)
+		ctx := context.Background()
+		set := nomix.NewTagSet()
+		tim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		set.TagSet(xtag.NewTime("A", tim))
+		assert.NoError(t, NewStore(tstRegistry()).Save(ctx, db, "o", set))
+
+		opt := WithTagOptions(nomix.WithZeroTime("2000-01-02T03:04:05Z"))
+		str := NewStore(tstRegistry(), opt)
+
+		// --- When ---
+		have, err := str.Load(ctx, db, "o")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Zero(t, have.TagGet("A").TagValue())
+	})
+
+	t.Run("error - query", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		fdb.fail = "SELECT"
+		str := NewStore(tstRegistry())
+
+		// --- When ---
+		have, err := str.Load(context.Background(), db, "o")
+
+		// --- Then ---
+		assert.ErrorIs(t, errFake, err)
+		assert.Equal(t, nomix.TagSet{}, have)
+	})
+
+	t.Run("error - kind not registered", func(t *testing.T) {
+		// --- Given ---
+		db, _ := tstDB(t)
+		ctx := context.Background()
+		set := nomix.NewTagSet()
+		set.TagSet(xtag.NewInt("A", 1))
+		assert.NoError(t, NewStore(tstRegistry()).Save(ctx, db, "o", set))
+		str := NewStore(nomix.NewRegistry())
+
+		// --- When ---
+		have, err := str.Load(ctx, db, "o")
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrNoSpec, err)
+		assert.ErrorEqual(t, "o: A: spec not found for KindInt(516)", err)
+		assert.Equal(t, nomix.TagSet{}, have)
+	})
+
+	t.Run("error - unsupported kind", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		fdb.rows = [][]driver.Value{
+			{
+				"o", "A", int64(0x7F), int64(0),
+				nil, nil, nil, nil, nil, nil, nil,
+			},
+		}
+		str := NewStore(tstRegistry())
+
+		// --- When ---
+		have, err := str.Load(context.Background(), db, "o")
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.ErrorContain(t, "o: A: ", err)
+		assert.Equal(t, nomix.TagSet{}, have)
+	})
+}
+
+func Test_Store_LoadMany(t *testing.T) {
+	t.Run("batches", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		ctx := context.Background()
+		str := NewStore(tstRegistry(), WithBatchSize(2))
+		sets := map[string]nomix.TagSet{
+			"a": nomix.NewTagSet(),
+			"b": nomix.NewTagSet(),
+			"c": nomix.NewTagSet(),
+		}
+		sets["a"].TagSet(xtag.NewInt("A", 1), xtag.NewIntSlice("B", 2, 3))
+		sets["b"].TagSet(xtag.NewInt("A", 4))
+		sets["c"].TagSet(xtag.NewStringSlice("C", "c"))
+		assert.NoError(t, str.SaveMany(ctx, db, sets))
+
+		// --- When ---
+		have, err := str.LoadMany(ctx, db, "a", "b", "c", "d")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 2, fdb.Queries("SELECT"))
+		assert.Len(t, 4, have)
+		exp := map[string]any{"A": 1, "B": []int{2, 3}}
+		assert.Equal(t, exp, have["a"].MetaGetAll())
+		assert.Equal(t, map[string]any{"A": 4}, have["b"].MetaGetAll())
+		exp = map[string]any{"C": []string{"c"}}
+		assert.Equal(t, exp, have["c"].MetaGetAll())
+		assert.Equal(t, 0, have["d"].TagCount())
+	})
+
+	t.Run("no owners", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		str := NewStore(tstRegistry())
+
+		// --- When ---
+		have, err := str.LoadMany(context.Background(), db)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 0, have)
+		assert.Nil(t, fdb.Queries("SELECT"))
+	})
+}
+
+func Test_Store_Delete(t *testing.T) {
+	t.Run("all tags", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		ctx := context.Background()
+		str := NewStore(tstRegistry())
+		assert.NoError(t, str.Save(ctx, db, "o", tstTagSet()))
+		assert.NoError(t, str.Save(ctx, db, "x", tstTagSet()))
+
+		// --- When ---
+		err := str.Delete(ctx, db, "o")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		have, err := str.LoadMany(ctx, db, "o", "x")
+		assert.NoError(t, err)
+		assert.Equal(t, 0, have["o"].TagCount())
+		assert.Equal(t, tstTagSet().TagCount(), have["x"].TagCount())
+		exp := "DELETE FROM tags WHERE owner_id IN ($1)"
+		queries := fdb.Queries("DELETE")
+		assert.Equal(t, exp, queries[len(queries)-1])
+	})
+
+	t.Run("named tags", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		ctx := context.Background()
+		str := NewStore(tstRegistry())
+		set := nomix.NewTagSet()
+		set.TagSet(
+			xtag.NewInt("A", 1),
+			xtag.NewIntSlice("B", 2, 3),
+			xtag.NewInt("C", 4),
+		)
+		assert.NoError(t, str.Save(ctx, db, "o", set))
+
+		// --- When ---
+		err := str.Delete(ctx, db, "o", "A", "B")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		have, err := str.Load(ctx, db, "o")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"C": 4}, have.MetaGetAll())
+		exp := "DELETE FROM tags WHERE owner_id IN ($1) AND name IN ($2, $3)"
+		queries := fdb.Queries("DELETE")
+		assert.Equal(t, exp, queries[len(queries)-1])
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		fdb.fail = "DELETE"
+		str := NewStore(tstRegistry())
+
+		// --- When ---
+		err := str.Delete(context.Background(), db, "o")
+
+		// --- Then ---
+		assert.ErrorIs(t, errFake, err)
+	})
+}
+
+func Test_Tx(t *testing.T) {
+	t.Run("commit", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+
+		// --- When ---
+		err := Tx(context.Background(), db, func(*sql.Tx) error { return nil })
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 1, fdb.commits)
+		assert.Equal(t, 0, fdb.backs)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+
+		// --- When ---
+		err := Tx(context.Background(), db, func(*sql.Tx) error {
+			return errFake
+		})
+
+		// --- Then ---
+		assert.ErrorIs(t, errFake, err)
+		assert.Equal(t, 0, fdb.commits)
+		assert.Equal(t, 1, fdb.backs)
+	})
+
+	t.Run("error - begin", func(t *testing.T) {
+		// --- Given ---
+		db, fdb := tstDB(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// --- When ---
+		err := Tx(ctx, db, func(*sql.Tx) error { return nil })
+
+		// --- Then ---
+		assert.ErrorIs(t, context.Canceled, err)
+		assert.Equal(t, 0, fdb.commits)
+	})
+}
@@ -0,0 +1,292 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomixsql
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ctx42/nomix/pkg/filter"
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+)
+
+// Where compiles the filter expression to the parameterised SQL predicate
+// selecting owners with tags matching the expression, configured with the
+// [Options.Table] and [Options.Dialect] options. Returns the predicate and
+// its arguments. Parameters are numbered from 1.
+//
+// The predicate references the [ColOwner] column of the outer query, for
+// example:
+//
+//	where, args, err := nomixsql.Where(expr, defs)
+//	query := "SELECT DISTINCT owner_id FROM tags WHERE " + where
+//
+// Each tag referenced by the expression must have a definition, its kind
+// selects the value column the tag is compared on, and the type of the
+// argument passed to the driver. Slice tags match when any of their
+// elements match, and comparisons of tags the owner doesn't have are false,
+// the same way as when the expression is evaluated with [filter.Expr.Eval].
+//
+// Returns an error if the tag has no definition, the operator is not
+// supported for the tag kind, or the value cannot be compared with the tag.
+func Where(
+	expr filter.Expr,
+	defs []*nomix.Definition,
+	opts ...Option,
+) (string, []any, error) {
+
+	w := &whereBuilder{
+		opts: NewOptions(opts...),
+		defs: make(map[string]*nomix.Definition, len(defs)),
+	}
+	for _, def := range defs {
+		w.defs[def.TagName()] = def
+	}
+	if err := w.expr(expr); err != nil {
+		return "", nil, err
+	}
+	return w.buf.String(), w.args, nil
+}
+
+// whereBuilder builds the SQL predicate from the filter expression.
+type whereBuilder struct {
+	opts Options                      // Store options.
+	defs map[string]*nomix.Definition // Definitions by tag name.
+	buf  strings.Builder              // Predicate.
+	args []any                        // Predicate arguments.
+}
+
+// expr writes the predicate for the expression.
+func (w *whereBuilder) expr(expr filter.Expr) error {
+	switch e := expr.(type) {
+	case *filter.And:
+		return w.binary("AND", e.Left, e.Right)
+
+	case *filter.Or:
+		return w.binary("OR", e.Left, e.Right)
+
+	case *filter.Not:
+		w.buf.WriteString("NOT (")
+		if err := w.expr(e.Expr); err != nil {
+			return err
+		}
+		w.buf.WriteString(")")
+		return nil
+
+	case *filter.Exists:
+		w.subquery(e.Name)
+		w.buf.WriteString(")")
+		return nil
+
+	case *filter.Compare:
+		return w.compare(e.Name, e.Op.String(), e.Op, e.Value)
+
+	case *filter.In:
+		return w.in(e)
+
+	case *filter.Contains:
+		def, err := w.def(e.Name)
+		if err != nil {
+			return err
+		}
+		if !def.TagKind().IsSlice() {
+			const format = "%s: %w: CONTAINS for %s"
+			knd := def.TagKind()
+			return fmt.Errorf(format, e.Name, filter.ErrNotSupported, knd)
+		}
+		return w.compare(e.Name, "CONTAINS", filter.OpEq, e.Value)
+	}
+	return fmt.Errorf("%w: %T expression", nomix.ErrNotImpl, expr)
+}
+
+// binary writes the predicate for the logical operator.
+func (w *whereBuilder) binary(op string, left, right filter.Expr) error {
+	w.buf.WriteString("(")
+	if err := w.expr(left); err != nil {
+		return err
+	}
+	w.buf.WriteString(" " + op + " ")
+	if err := w.expr(right); err != nil {
+		return err
+	}
+	w.buf.WriteString(")")
+	return nil
+}
+
+// compare writes the predicate comparing the named tag value with the value
+// using the operator. The operator name is used in error messages.
+func (w *whereBuilder) compare(
+	name string,
+	opName string,
+	op filter.Op,
+	val any,
+) error {
+
+	col, arg, err := w.arg(name, opName, op, val)
+	if err != nil {
+		return err
+	}
+	w.subquery(name)
+	w.buf.WriteString(" AND " + col + " " + op.String() + " ")
+	w.buf.WriteString(w.param(arg))
+	w.buf.WriteString(")")
+	return nil
+}
+
+// in writes the predicate checking if the named tag value is equal to any of
+// the values.
+func (w *whereBuilder) in(e *filter.In) error {
+	if _, err := w.def(e.Name); err != nil {
+		return err
+	}
+	if len(e.Values) == 0 {
+		w.buf.WriteString("1 = 0")
+		return nil
+	}
+	var col string
+	args := make([]any, len(e.Values))
+	for i, val := range e.Values {
+		var err error
+		col, args[i], err = w.arg(e.Name, "IN", filter.OpEq, val)
+		if err != nil {
+			return err
+		}
+	}
+	w.subquery(e.Name)
+	w.buf.WriteString(" AND " + col + " IN (")
+	for i, arg := range args {
+		if i > 0 {
+			w.buf.WriteString(", ")
+		}
+		w.buf.WriteString(w.param(arg))
+	}
+	w.buf.WriteString("))")
+	return nil
+}
+
+// subquery writes the beginning of the subquery selecting owners having rows
+// of the named tag. The caller may add conditions and must close it.
+func (w *whereBuilder) subquery(name string) {
+	w.buf.WriteString(ColOwner)
+	w.buf.WriteString(" IN (SELECT ")
+	w.buf.WriteString(ColOwner)
+	w.buf.WriteString(" FROM ")
+	w.buf.WriteString(w.opts.Table)
+	w.buf.WriteString(" WHERE ")
+	w.buf.WriteString(ColName)
+	w.buf.WriteString(" = ")
+	w.buf.WriteString(w.param(name))
+}
+
+// param adds the argument and returns its placeholder.
+func (w *whereBuilder) param(arg any) string {
+	w.args = append(w.args, arg)
+	return w.opts.Dialect.Placeholder(len(w.args))
+}
+
+// def returns the definition of the named tag.
+func (w *whereBuilder) def(name string) (*nomix.Definition, error) {
+	def, ok := w.defs[name]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w: tag definition", name, nomix.ErrMissing)
+	}
+	return def, nil
+}
+
+// arg returns the value column of the named tag, and the value converted to
+// the type of the column. The operator name is used in error messages.
+func (w *whereBuilder) arg(
+	name string,
+	opName string,
+	op filter.Op,
+	val any,
+) (string, any, error) {
+
+	def, err := w.def(name)
+	if err != nil {
+		return "", nil, err
+	}
+	knd := def.TagKind()
+	col, err := Column(knd)
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %w", name, err)
+	}
+	arg, err := whereArg(knd, op, val)
+	if err != nil {
+		if errors.Is(err, filter.ErrNotSupported) {
+			const format = "%s: %w: %s for %s"
+			return "", nil, fmt.Errorf(format, name, err, opName, knd)
+		}
+		return "", nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return col, arg, nil
+}
+
+// whereArg converts the filter value to the type of the value column of the
+// given kind. Returns [filter.ErrNotSupported] if the operator is not
+// supported for the kind.
+//
+// nolint: cyclop
+func whereArg(knd nomix.Kind, op filter.Op, val any) (any, error) {
+	equality := op == filter.OpEq || op == filter.OpNe
+	switch knd.Base() {
+	case nomix.KindInt64:
+		if knd&^nomix.KindSlice == nomix.KindBool {
+			if v, ok := val.(bool); ok {
+				if !equality {
+					return nil, filter.ErrNotSupported
+				}
+				if v {
+					return int64(1), nil
+				}
+				return int64(0), nil
+			}
+			break
+		}
+		switch v := val.(type) {
+		case int64, float64:
+			return v, nil
+		}
+
+	case nomix.KindFloat64:
+		switch v := val.(type) {
+		case int64:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		}
+
+	case nomix.KindString:
+		if v, ok := val.(string); ok {
+			return v, nil
+		}
+
+	case nomix.KindTime:
+		if v, ok := val.(string); ok {
+			tim, err := nomix.ParseTime(v, nomix.NewOptions())
+			if err != nil {
+				return nil, err
+			}
+			return tim, nil
+		}
+
+	case nomix.KindUUID:
+		if v, ok := val.(string); ok {
+			if !equality {
+				return nil, filter.ErrNotSupported
+			}
+			tag, err := xtag.ParseUUID("", v)
+			if err != nil {
+				return nil, nomix.ErrInvFormat
+			}
+			return xtag.FormatUUID(tag.Get()), nil
+		}
+
+	default:
+		return nil, filter.ErrNotSupported
+	}
+	return nil, fmt.Errorf("%w: %T value", nomix.ErrInvType, val)
+}
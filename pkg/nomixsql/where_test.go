@@ -0,0 +1,289 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package nomixsql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+
+	"github.com/ctx42/nomix/pkg/filter"
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+)
+
+// tstDefs returns tag definitions used in tests.
+func tstDefs() []*nomix.Definition {
+	return []*nomix.Definition{
+		nomix.Define("env", xtag.StringSpec()),
+		nomix.Define("replicas", xtag.IntSpec()),
+		nomix.Define("load", xtag.Float64Spec()),
+		nomix.Define("canary", xtag.BoolSpec()),
+		nomix.Define("created", xtag.TimeSpec()),
+		nomix.Define("id", xtag.UUIDSpec()),
+		nomix.Define("zones", xtag.StringSliceSpec()),
+		nomix.Define("flags", xtag.BoolSliceSpec()),
+		nomix.Define("doc", xtag.JSONSpec()),
+		nomix.Define("raw", xtag.ByteSliceSpec()),
+	}
+}
+
+func Test_Where(t *testing.T) {
+	t.Run("PostgreSQL", func(t *testing.T) {
+		// --- Given ---
+		expr := filter.MustParse(
+			`env = "prod" AND replicas >= 3 AND zones IN ("eu", "us")`,
+		)
+
+		// --- When ---
+		have, args, err := Where(expr, tstDefs())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := "((" +
+			"owner_id IN (SELECT owner_id FROM tags " +
+			"WHERE name = $1 AND val_string = $2) AND " +
+			"owner_id IN (SELECT owner_id FROM tags " +
+			"WHERE name = $3 AND val_int64 >= $4)) AND " +
+			"owner_id IN (SELECT owner_id FROM tags " +
+			"WHERE name = $5 AND val_string IN ($6, $7)))"
+		assert.Equal(t, exp, have)
+		expArgs := []any{
+			"env", "prod", "replicas", int64(3), "zones", "eu", "us",
+		}
+		assert.Equal(t, expArgs, args)
+	})
+
+	t.Run("MySQL", func(t *testing.T) {
+		// --- Given ---
+		expr := filter.MustParse(`NOT EXISTS env OR load < 1`)
+
+		// --- When ---
+		have, args, err := Where(
+			expr,
+			tstDefs(),
+			WithDialect(MySQL),
+			WithTable("meta"),
+		)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := "(" +
+			"NOT (owner_id IN (SELECT owner_id FROM meta WHERE name = ?)) OR " +
+			"owner_id IN (SELECT owner_id FROM meta " +
+			"WHERE name = ? AND val_float64 < ?))"
+		assert.Equal(t, exp, have)
+		assert.Equal(t, []any{"env", "load", float64(1)}, args)
+	})
+
+	t.Run("error - tag without definition", func(t *testing.T) {
+		// --- Given ---
+		expr := filter.MustParse(`env = "prod" AND abc = 1`)
+
+		// --- When ---
+		have, args, err := Where(expr, tstDefs())
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrMissing, err)
+		assert.ErrorEqual(t, "abc: missing element: tag definition", err)
+		assert.Equal(t, "", have)
+		assert.Nil(t, args)
+	})
+
+	t.Run("error - not implemented expression", func(t *testing.T) {
+		// --- When ---
+		have, args, err := Where(nil, tstDefs())
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrNotImpl, err)
+		assert.Equal(t, "", have)
+		assert.Nil(t, args)
+	})
+}
+
+func Test_Where_tabular(t *testing.T) {
+	tim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tt := []struct {
+		testN string
+
+		query string
+		cond  string
+		arg   any
+	}{
+		{"string", `env != "qa"`, "val_string != $2", "qa"},
+		{"int", `replicas = 3`, "val_int64 = $2", int64(3)},
+		{"int with float", `replicas < 2.5`, "val_int64 < $2", 2.5},
+		{"float", `load >= 0.5`, "val_float64 >= $2", 0.5},
+		{"float with int", `load > 1`, "val_float64 > $2", float64(1)},
+		{"bool true", `canary = true`, "val_int64 = $2", int64(1)},
+		{"bool false", `canary != false`, "val_int64 != $2", int64(0)},
+		{
+			"time",
+			`created > "2000-01-02T03:04:05Z"`,
+			"val_time > $2",
+			tim,
+		},
+		{
+			"uuid",
+			`id = "6BA7B810-9DAD-11D1-80B4-00C04FD430C8"`,
+			"val_uuid = $2",
+			tstUUIDStr,
+		},
+		{
+			"uuid braces",
+			`id = "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"`,
+			"val_uuid = $2",
+			tstUUIDStr,
+		},
+		{
+			"uuid no dashes",
+			`id != "6ba7b8109dad11d180b400c04fd430c8"`,
+			"val_uuid != $2",
+			tstUUIDStr,
+		},
+		{"contains", `zones CONTAINS "eu"`, "val_string = $2", "eu"},
+		{"bool slice", `flags CONTAINS true`, "val_int64 = $2", int64(1)},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			expr := filter.MustParse(tc.query)
+
+			// --- When ---
+			have, args, err := Where(expr, tstDefs())
+
+			// --- Then ---
+			assert.NoError(t, err)
+			exp := "owner_id IN (SELECT owner_id FROM tags " +
+				"WHERE name = $1 AND " + tc.cond + ")"
+			assert.Equal(t, exp, have)
+			assert.Len(t, 2, args)
+			assert.Equal(t, tc.arg, args[1])
+		})
+	}
+}
+
+func Test_Where_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		query string
+		exp   string
+	}{
+		{
+			"type mismatch",
+			`env = 1`,
+			"env: invalid element type: int64 value",
+		},
+		{
+			"bool with int",
+			`canary = 1`,
+			"canary: invalid element type: int64 value",
+		},
+		{
+			"int with bool",
+			`replicas = true`,
+			"replicas: invalid element type: bool value",
+		},
+		{
+			"bool ordering",
+			`canary < true`,
+			"canary: operator not supported: < for KindBool",
+		},
+		{
+			"uuid ordering",
+			`id >= "abc"`,
+			"id: operator not supported: >= for KindUUID",
+		},
+		{
+			"invalid time",
+			`created = "abc"`,
+			"created: invalid element format",
+		},
+		{
+			"invalid uuid",
+			`id = "abc"`,
+			"id: invalid element format",
+		},
+		{
+			"json",
+			`doc = "abc"`,
+			"doc: operator not supported: = for KindJSON",
+		},
+		{
+			"byte slice",
+			`raw IN (1)`,
+			"raw: operator not supported: IN for KindByteSlice",
+		},
+		{
+			"contains single value",
+			`env CONTAINS "prod"`,
+			"env: operator not supported: CONTAINS for KindString",
+		},
+		{
+			"contains without definition",
+			`abc CONTAINS "prod"`,
+			"abc: missing element: tag definition",
+		},
+		{
+			"in second value",
+			`env IN ("a", 1)`,
+			"env: invalid element type: int64 value",
+		},
+		{
+			"not",
+			`NOT env = 1`,
+			"env: invalid element type: int64 value",
+		},
+		{
+			"left operand",
+			`env = 1 OR EXISTS env`,
+			"env: invalid element type: int64 value",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			expr := filter.MustParse(tc.query)
+
+			// --- When ---
+			have, args, err := Where(expr, tstDefs())
+
+			// --- Then ---
+			assert.ErrorContain(t, tc.exp, err)
+			assert.Equal(t, "", have)
+			assert.Nil(t, args)
+		})
+	}
+}
+
+func Test_Where_empty_in(t *testing.T) {
+	t.Run("no values", func(t *testing.T) {
+		// --- Given ---
+		expr := &filter.In{Name: "env"}
+
+		// --- When ---
+		have, args, err := Where(expr, tstDefs())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "1 = 0", have)
+		assert.Nil(t, args)
+	})
+
+	t.Run("error - tag without definition", func(t *testing.T) {
+		// --- Given ---
+		expr := &filter.In{Name: "abc"}
+
+		// --- When ---
+		_, _, err := Where(expr, tstDefs())
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrMissing, err)
+	})
+}
@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package selector
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// special lists characters which cannot be used in keys and values.
+const special = ",=!()<>"
+
+// Token types.
+const (
+	tokEOF    = iota // End of the selector.
+	tokIdent         // Key or value.
+	tokOp            // Operator.
+	tokLParen        // Left parenthesis.
+	tokRParen        // Right parenthesis.
+	tokComma         // Comma.
+)
+
+// token represents a lexical token.
+type token struct {
+	typ int    // Token type.
+	pos int    // Byte offset of the token in the selector.
+	str string // Token text.
+}
+
+// describe returns the token description used in error messages.
+func (t token) describe() string {
+	if t.typ == tokEOF {
+		return "end of selector"
+	}
+	return fmt.Sprintf("%q", t.str)
+}
+
+// Parse parses the selector. An empty, or blank, string is parsed as the
+// empty selector matching all tag sets. Returns an error wrapping
+// [ErrSyntax] if the selector is malformed.
+func Parse(str string) (Selector, error) {
+	p := &parser{src: str}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	sel := Selector{}
+	if p.tok.typ == tokEOF {
+		return sel, nil
+	}
+	for {
+		req, err := p.parseRequirement()
+		if err != nil {
+			return nil, err
+		}
+		sel = append(sel, req)
+		switch p.tok.typ {
+		case tokEOF:
+			return sel, nil
+		case tokComma:
+			if err = p.next(); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, p.expected(`","`)
+		}
+	}
+}
+
+// MustParse parses the selector. Panics on error.
+func MustParse(str string) Selector {
+	sel, err := Parse(str)
+	if err != nil {
+		panic(err)
+	}
+	return sel
+}
+
+// parser represents the selector parser.
+type parser struct {
+	src string // Selector source.
+	off int    // Byte offset of the next token.
+	tok token  // Current token.
+}
+
+// parseRequirement parses a single requirement.
+func (p *parser) parseRequirement() (Requirement, error) {
+	if p.tok.typ == tokOp && p.tok.str == "!" {
+		if err := p.next(); err != nil {
+			return Requirement{}, err
+		}
+		if p.tok.typ != tokIdent {
+			return Requirement{}, p.expected("key")
+		}
+		req := Requirement{Key: p.tok.str, Op: DoesNotExist}
+		return req, p.next()
+	}
+	if p.tok.typ != tokIdent {
+		return Requirement{}, p.expected("key")
+	}
+	req := Requirement{Key: p.tok.str}
+	if err := p.next(); err != nil {
+		return Requirement{}, err
+	}
+	switch {
+	case p.tok.typ == tokEOF || p.tok.typ == tokComma:
+		req.Op = Exists
+		return req, nil
+
+	case p.tok.typ == tokIdent && (p.tok.str == "in" || p.tok.str == "notin"):
+		req.Op = Operator(p.tok.str)
+		vals, err := p.parseValues()
+		if err != nil {
+			return Requirement{}, err
+		}
+		req.Values = vals
+		return req, nil
+
+	case p.tok.typ == tokOp && p.tok.str != "!":
+		req.Op = Operator(p.tok.str)
+		if err := p.next(); err != nil {
+			return Requirement{}, err
+		}
+		if req.Op == GreaterThan || req.Op == LessThan {
+			_, ok := parseNumber(p.tok.str)
+			if p.tok.typ != tokIdent || !ok {
+				return Requirement{}, p.expected("number")
+			}
+		}
+		if p.tok.typ != tokIdent {
+			// Equality operators allow empty values.
+			req.Values = []string{""}
+			return req, nil
+		}
+		req.Values = []string{p.tok.str}
+		return req, p.next()
+	}
+	return Requirement{}, p.expected("operator")
+}
+
+// parseValues parses the parenthesized list of values.
+func (p *parser) parseValues() ([]string, error) {
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if p.tok.typ != tokLParen {
+		return nil, p.expected(`"("`)
+	}
+	var vals []string
+	for {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if p.tok.typ != tokIdent {
+			return nil, p.expected("value")
+		}
+		vals = append(vals, p.tok.str)
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		switch p.tok.typ {
+		case tokComma:
+			continue
+		case tokRParen:
+			return vals, p.next()
+		default:
+			return nil, p.expected(`"," or ")"`)
+		}
+	}
+}
+
+// expected returns the syntax error for the unexpected current token.
+func (p *parser) expected(what string) error {
+	return p.error(p.tok.pos, "expected %s, got %s", what, p.tok.describe())
+}
+
+// error returns the syntax error at the given position.
+func (p *parser) error(pos int, format string, args ...any) error {
+	msg := fmt.Sprintf(format, args...)
+	return fmt.Errorf("%w at position %d: %s", ErrSyntax, pos, msg)
+}
+
+// next scans the next token.
+func (p *parser) next() error {
+	for p.off < len(p.src) {
+		r, size := utf8.DecodeRuneInString(p.src[p.off:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		p.off += size
+	}
+	p.tok = token{pos: p.off}
+	if p.off >= len(p.src) {
+		p.tok.typ = tokEOF
+		return nil
+	}
+	switch rest := p.src[p.off:]; {
+	case rest[0] == ',':
+		p.tok.typ = tokComma
+	case rest[0] == '(':
+		p.tok.typ = tokLParen
+	case rest[0] == ')':
+		p.tok.typ = tokRParen
+	case strings.HasPrefix(rest, "=="), strings.HasPrefix(rest, "!="):
+		p.tok.typ = tokOp
+		p.tok.str = rest[:2]
+	case strings.ContainsRune("=!<>", rune(rest[0])):
+		p.tok.typ = tokOp
+		p.tok.str = rest[:1]
+	default:
+		return p.scanIdent()
+	}
+	if p.tok.str == "" {
+		p.tok.str = p.src[p.off : p.off+1]
+	}
+	p.off += len(p.tok.str)
+	return nil
+}
+
+// scanIdent scans the key or value.
+func (p *parser) scanIdent() error {
+	end := p.off
+	for end < len(p.src) {
+		r, size := utf8.DecodeRuneInString(p.src[end:])
+		if r == utf8.RuneError && size == 1 {
+			return p.error(end, "invalid UTF-8 encoding")
+		}
+		if unicode.IsSpace(r) || strings.ContainsRune(special, r) {
+			break
+		}
+		end += size
+	}
+	p.tok.typ = tokIdent
+	p.tok.str = p.src[p.off:end]
+	p.off = end
+	return nil
+}
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package selector
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_Parse(t *testing.T) {
+	t.Run("all operators", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse(
+			"app=web,tier!=db,env in (prod,stage),!canary,ready," +
+				"a==b,zone notin (x),n>1,m<2.5",
+		)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := Selector{
+			{Key: "app", Op: Equals, Values: []string{"web"}},
+			{Key: "tier", Op: NotEquals, Values: []string{"db"}},
+			{Key: "env", Op: In, Values: []string{"prod", "stage"}},
+			{Key: "canary", Op: DoesNotExist},
+			{Key: "ready", Op: Exists},
+			{Key: "a", Op: DoubleEquals, Values: []string{"b"}},
+			{Key: "zone", Op: NotIn, Values: []string{"x"}},
+			{Key: "n", Op: GreaterThan, Values: []string{"1"}},
+			{Key: "m", Op: LessThan, Values: []string{"2.5"}},
+		}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("whitespace", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse("  app = web ,\tenv in( a , b ) , ! x ")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := Selector{
+			{Key: "app", Op: Equals, Values: []string{"web"}},
+			{Key: "env", Op: In, Values: []string{"a", "b"}},
+			{Key: "x", Op: DoesNotExist},
+		}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("empty value", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse("a=,b!=")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := Selector{
+			{Key: "a", Op: Equals, Values: []string{""}},
+			{Key: "b", Op: NotEquals, Values: []string{""}},
+		}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("key with prefix", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse("app.example.com/name=web-1_a.b")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := Selector{{
+			Key:    "app.example.com/name",
+			Op:     Equals,
+			Values: []string{"web-1_a.b"},
+		}}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse(" ")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, Selector{}, have)
+	})
+}
+
+func Test_Parse_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		sel string
+		exp string
+	}{
+		{
+			"missing key",
+			"=a",
+			`syntax error at position 0: expected key, got "="`,
+		},
+		{
+			"missing key after bang",
+			"!,a",
+			`syntax error at position 1: expected key, got ","`,
+		},
+		{
+			"trailing comma",
+			"a=b,",
+			"syntax error at position 4: expected key, got end of selector",
+		},
+		{
+			"missing operator",
+			"a b",
+			`syntax error at position 2: expected operator, got "b"`,
+		},
+		{
+			"bang after key",
+			"a!b",
+			`syntax error at position 1: expected operator, got "!"`,
+		},
+		{
+			"missing comma",
+			"a=b c",
+			`syntax error at position 4: expected ",", got "c"`,
+		},
+		{
+			"in without list",
+			"a in b",
+			`syntax error at position 5: expected "(", got "b"`,
+		},
+		{
+			"in empty list",
+			"a in ()",
+			`syntax error at position 6: expected value, got ")"`,
+		},
+		{
+			"in not closed",
+			"a in (b",
+			`syntax error at position 7: ` +
+				`expected "," or ")", got end of selector`,
+		},
+		{
+			"greater than without number",
+			"a>b",
+			`syntax error at position 2: expected number, got "b"`,
+		},
+		{
+			"less than without value",
+			"a<",
+			"syntax error at position 2: " +
+				"expected number, got end of selector",
+		},
+		{
+			"invalid encoding",
+			"a=\xff",
+			"syntax error at position 2: invalid UTF-8 encoding",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := Parse(tc.sel)
+
+			// --- Then ---
+			assert.ErrorIs(t, ErrSyntax, err)
+			assert.ErrorEqual(t, tc.exp, err)
+			assert.Nil(t, have)
+		})
+	}
+}
+
+func Test_MustParse(t *testing.T) {
+	// --- When ---
+	have := MustParse("a")
+
+	// --- Then ---
+	assert.Equal(t, Selector{{Key: "a", Op: Exists}}, have)
+}
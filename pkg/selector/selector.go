@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+// Package selector provides Kubernetes-style label selectors for tag sets.
+//
+// A selector is a comma separated list of requirements, all of which must be
+// met for the selector to match, for example:
+//
+//	app=web,tier!=db,env in (prod,stage),!canary
+//
+// Supported requirements:
+//
+//   - key=value, key==value: the tag exists and is equal to the value.
+//   - key!=value: the tag doesn't exist or is not equal to the value.
+//   - key in (v1,v2): the tag exists and is equal to any of the values.
+//   - key notin (v1,v2): the tag doesn't exist or is not equal to any of
+//     the values.
+//   - key: the tag exists.
+//   - !key: the tag doesn't exist.
+//   - key>value, key<value: the tag exists, is numeric and is greater or
+//     less than the value.
+//
+// Values of single value numeric tags, with the [nomix.KindInt64] or
+// [nomix.KindFloat64] base kinds, are compared numerically, so "n=1" and
+// "n=1.0" match the same tags. Other tags are compared using their string
+// form returned by the String method.
+package selector
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+// ErrSyntax is returned when the selector is malformed.
+var ErrSyntax = errors.New("syntax error")
+
+// Operator represents the requirement operator.
+type Operator string
+
+// Requirement operators.
+const (
+	Equals       Operator = "="
+	DoubleEquals Operator = "=="
+	NotEquals    Operator = "!="
+	In           Operator = "in"
+	NotIn        Operator = "notin"
+	Exists       Operator = "exists"
+	DoesNotExist Operator = "!"
+	GreaterThan  Operator = ">"
+	LessThan     Operator = "<"
+)
+
+// Requirement represents a single requirement of the selector.
+type Requirement struct {
+	Key    string   // Tag name.
+	Op     Operator // Requirement operator.
+	Values []string // Values, empty for existence operators.
+}
+
+// Matches returns true if the tags meet the requirement.
+func (r Requirement) Matches(tags nomix.Tagger) bool {
+	tag := tags.TagGet(r.Key)
+	switch r.Op {
+	case Exists:
+		return tag != nil
+	case DoesNotExist:
+		return tag == nil
+	case Equals, DoubleEquals, In:
+		return tag != nil && r.hasValue(tag)
+	case NotEquals, NotIn:
+		return tag == nil || !r.hasValue(tag)
+	case GreaterThan, LessThan:
+		if tag == nil || len(r.Values) != 1 {
+			return false
+		}
+		num, ok := tagNumber(tag)
+		if !ok {
+			return false
+		}
+		val, ok := parseNumber(r.Values[0])
+		if !ok {
+			return false
+		}
+		res := compareNumbers(num, val)
+		return r.Op == GreaterThan && res > 0 || r.Op == LessThan && res < 0
+	default:
+		return false
+	}
+}
+
+// hasValue returns true if the tag is equal to any of the values.
+func (r Requirement) hasValue(tag nomix.Tag) bool {
+	if num, ok := tagNumber(tag); ok {
+		for _, str := range r.Values {
+			val, ok := parseNumber(str)
+			if ok && compareNumbers(num, val) == 0 {
+				return true
+			}
+		}
+		return false
+	}
+	str := tagString(tag)
+	for _, val := range r.Values {
+		if str == val {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the requirement in the selector syntax.
+func (r Requirement) String() string {
+	switch r.Op {
+	case Exists:
+		return r.Key
+	case DoesNotExist:
+		return "!" + r.Key
+	case In, NotIn:
+		vals := strings.Join(r.Values, ",")
+		return r.Key + " " + string(r.Op) + " (" + vals + ")"
+	default:
+		return r.Key + string(r.Op) + strings.Join(r.Values, ",")
+	}
+}
+
+// Selector represents the label selector. An empty selector matches all tag
+// sets.
+type Selector []Requirement
+
+// Matches returns true if the tags meet all selector requirements.
+func (s Selector) Matches(tags nomix.Tagger) bool {
+	for _, r := range s {
+		if !r.Matches(tags) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the selector in the selector syntax.
+func (s Selector) String() string {
+	strs := make([]string, len(s))
+	for i, r := range s {
+		strs[i] = r.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+// tagString returns the string form of the tag value.
+func tagString(tag nomix.Tag) string {
+	if str, ok := tag.(fmt.Stringer); ok {
+		return str.String()
+	}
+	return fmt.Sprint(tag.TagValue())
+}
+
+// tagNumber returns the value of the single value numeric tag as int64 or
+// float64. Returns false if the tag is not numeric.
+func tagNumber(tag nomix.Tag) (any, bool) {
+	knd := tag.TagKind()
+	if knd.IsSlice() {
+		return nil, false
+	}
+	val := reflect.ValueOf(tag.TagValue())
+	switch knd.Base() {
+	case nomix.KindInt64:
+		switch val.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+			reflect.Int64:
+			return val.Int(), true
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+			reflect.Uint64:
+			if val.Uint() > math.MaxInt64 {
+				return float64(val.Uint()), true
+			}
+			return int64(val.Uint()), true
+		}
+	case nomix.KindFloat64:
+		if val.CanFloat() {
+			return val.Float(), true
+		}
+	}
+	return nil, false
+}
+
+// parseNumber parses the string as int64 or float64.
+func parseNumber(str string) (any, bool) {
+	if v, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return v, true
+	}
+	if v, err := strconv.ParseFloat(str, 64); err == nil {
+		return v, true
+	}
+	return nil, false
+}
+
+// compareNumbers compares two int64 or float64 numbers.
+func compareNumbers(a, b any) int {
+	ai, aInt := a.(int64)
+	bi, bInt := b.(int64)
+	if aInt && bInt {
+		return cmp.Compare(ai, bi)
+	}
+	return cmp.Compare(toFloat(a), toFloat(b))
+}
+
+// toFloat returns the int64 or float64 number as float64.
+func toFloat(v any) float64 {
+	switch n := v.(type) {
+	case int64:
+		return float64(n)
+	case float64:
+		return n
+	default:
+		return math.NaN()
+	}
+}
@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package selector
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+)
+
+// tstTagSet returns a tag set used in tests.
+func tstTagSet() nomix.TagSet {
+	set := nomix.NewTagSet()
+	set.TagSet(
+		xtag.NewString("app", "web"),
+		xtag.NewString("tier", "frontend"),
+		xtag.NewString("env", "prod"),
+		xtag.NewInt("replicas", 3),
+		xtag.NewFloat64("load", 0.5),
+		xtag.NewBool("ready", true),
+		xtag.NewStringSlice("zones", "a", "b"),
+	)
+	return set
+}
+
+func Test_Selector_Matches_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		sel string
+		exp bool
+	}{
+		{"empty", "", true},
+		{"example", "app=web,tier!=db,env in (prod,stage),!canary", true},
+		{"equals", "app=web", true},
+		{"equals other", "app=api", false},
+		{"equals missing", "abc=web", false},
+		{"double equals", "app==web", true},
+		{"not equals", "app!=web", false},
+		{"not equals missing", "abc!=web", true},
+		{"in", "env in (qa,prod)", true},
+		{"in none", "env in (qa,dev)", false},
+		{"in missing", "abc in (qa)", false},
+		{"not in", "env notin (qa,dev)", true},
+		{"not in found", "env notin (qa,prod)", false},
+		{"not in missing", "abc notin (qa)", true},
+		{"exists", "app", true},
+		{"exists missing", "abc", false},
+		{"does not exist", "!app", false},
+		{"does not exist missing", "!abc", true},
+		{"int", "replicas=3", true},
+		{"int as float", "replicas=3.0", true},
+		{"int leading zero", "replicas=03", true},
+		{"int not number", "replicas=three", false},
+		{"int in", "replicas in (1,3)", true},
+		{"int not equals", "replicas!=3.0", false},
+		{"int greater", "replicas>2", true},
+		{"int greater equal", "replicas>3", false},
+		{"int less", "replicas<3.5", true},
+		{"float", "load=0.50", true},
+		{"float less", "load<1", true},
+		{"float greater", "load>1", false},
+		{"bool", "ready=true", true},
+		{"bool not numeric", "ready>0", false},
+		{"string not numeric", "app>0", false},
+		{"slice string form", "zones=a", false},
+		{"greater missing", "abc>1", false},
+		{"all", "app=web,replicas>1,!abc", true},
+		{"one fails", "app=web,replicas>5,!abc", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			sel := MustParse(tc.sel)
+
+			// --- When ---
+			have := sel.Matches(tstTagSet())
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_Requirement_Matches(t *testing.T) {
+	t.Run("unknown operator", func(t *testing.T) {
+		// --- Given ---
+		req := Requirement{Key: "app", Op: "abc", Values: []string{"web"}}
+
+		// --- When ---
+		have := req.Matches(tstTagSet())
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("greater than without value", func(t *testing.T) {
+		// --- Given ---
+		req := Requirement{Key: "replicas", Op: GreaterThan}
+
+		// --- When ---
+		have := req.Matches(tstTagSet())
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("greater than not number", func(t *testing.T) {
+		// --- Given ---
+		req := Requirement{
+			Key:    "replicas",
+			Op:     GreaterThan,
+			Values: []string{"abc"},
+		}
+
+		// --- When ---
+		have := req.Matches(tstTagSet())
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("uint value", func(t *testing.T) {
+		// --- Given ---
+		set := nomix.NewTagSet()
+		set.TagSet(nomix.NewSingle(
+			"n", uint64(1<<63), nomix.KindInt64, nil, nil, nil,
+		))
+		req := Requirement{Key: "n", Op: GreaterThan, Values: []string{"1"}}
+
+		// --- When ---
+		have := req.Matches(set)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+}
+
+func Test_Selector_String_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		sel string
+		exp string
+	}{
+		{"empty", "", ""},
+		{
+			"example",
+			"app = web, tier!=db,env in(prod, stage), ! canary",
+			"app=web,tier!=db,env in (prod,stage),!canary",
+		},
+		{"double equals", "a==b", "a==b"},
+		{"exists", "a", "a"},
+		{"not in", "a notin (b)", "a notin (b)"},
+		{"empty value", "a=", "a="},
+		{"numbers", "a>1,b<2.5", "a>1,b<2.5"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			sel := MustParse(tc.sel)
+
+			// --- When ---
+			have := sel.String()
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+			assert.Equal(t, sel, MustParse(have))
+		})
+	}
+}
+
+func Test_tagString(t *testing.T) {
+	t.Run("stringer", func(t *testing.T) {
+		// --- When ---
+		have := tagString(xtag.NewInt("n", 42))
+
+		// --- Then ---
+		assert.Equal(t, "42", have)
+	})
+
+	t.Run("not stringer", func(t *testing.T) {
+		// --- Given ---
+		tag := tstTag{name: "n", value: 42}
+
+		// --- When ---
+		have := tagString(tag)
+
+		// --- Then ---
+		assert.Equal(t, "42", have)
+	})
+}
+
+// tstTag is a [nomix.Tag] not implementing [fmt.Stringer].
+type tstTag struct {
+	name  string
+	value any
+}
+
+func (tag tstTag) TagName() string     { return tag.name }
+func (tag tstTag) TagKind() nomix.Kind { return nomix.KindInt }
+func (tag tstTag) TagValue() any       { return tag.value }
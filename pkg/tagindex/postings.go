@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package tagindex
+
+import (
+	"cmp"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+)
+
+// ownerSet represents a set of owner IDs.
+type ownerSet map[string]struct{}
+
+// entry represents the sorted postings entry.
+type entry struct {
+	key   any    // Element key.
+	owner string // Owner ID.
+}
+
+// postings represent postings of tags with the same name and kind.
+//
+// Entries of ranged kinds are appended as tags are added and left in place
+// as tags are removed. They are sorted, and entries of removed owners are
+// dropped, on the first range query after the change or when there are
+// more than twice as many entries as there are live ones.
+type postings struct {
+	knd    nomix.Kind       // Tag kind.
+	owners ownerSet         // Owners with the tag.
+	exact  map[any]ownerSet // Owners by element key, nil if not indexed.
+	sorted []entry          // Entries sorted by key when not dirty.
+	live   int              // Number of live entries.
+	dirty  bool             // Set when entries must be sorted.
+	mu     sync.Mutex       // Guards sorting entries during queries.
+}
+
+// newPostings returns new postings for tags of the given kind.
+func newPostings(knd nomix.Kind) *postings {
+	pst := &postings{knd: knd, owners: make(ownerSet)}
+	if indexed(knd) {
+		pst.exact = make(map[any]ownerSet)
+	}
+	return pst
+}
+
+// indexed returns true if values of the kind are indexed.
+func indexed(knd nomix.Kind) bool {
+	switch knd.Base() {
+	case nomix.KindString, nomix.KindInt64, nomix.KindFloat64,
+		nomix.KindTime, nomix.KindUUID:
+		return true
+	default:
+		return false
+	}
+}
+
+// ranged returns true if values of the kind are kept sorted.
+func ranged(knd nomix.Kind) bool {
+	if knd&^nomix.KindSlice == nomix.KindBool {
+		return false
+	}
+	switch knd.Base() {
+	case nomix.KindInt64, nomix.KindFloat64, nomix.KindTime:
+		return true
+	default:
+		return false
+	}
+}
+
+// add adds the owner's tag to the postings.
+func (pst *postings) add(owner string, tag nomix.Tag) {
+	pst.owners[owner] = struct{}{}
+	if pst.exact == nil {
+		return
+	}
+	for _, key := range elemKeys(tag) {
+		owners, ok := pst.exact[key]
+		if !ok {
+			owners = make(ownerSet)
+			pst.exact[key] = owners
+		}
+		if _, ok = owners[owner]; ok {
+			continue
+		}
+		owners[owner] = struct{}{}
+		if ranged(pst.knd) {
+			pst.sorted = append(pst.sorted, entry{key: key, owner: owner})
+			pst.live++
+			pst.dirty = true
+		}
+	}
+	pst.compact()
+}
+
+// remove removes the owner's tag from the postings. Returns false if the
+// postings are empty after the removal.
+func (pst *postings) remove(owner string, tag nomix.Tag) bool {
+	delete(pst.owners, owner)
+	if pst.exact != nil {
+		for _, key := range elemKeys(tag) {
+			owners, ok := pst.exact[key]
+			if !ok {
+				continue
+			}
+			if _, ok = owners[owner]; !ok {
+				continue
+			}
+			delete(owners, owner)
+			if len(owners) == 0 {
+				delete(pst.exact, key)
+			}
+			if ranged(pst.knd) {
+				pst.live--
+				pst.dirty = true
+			}
+		}
+		pst.compact()
+	}
+	return len(pst.owners) > 0
+}
+
+// compact sorts entries when more than half of them are stale, so the
+// memory used by entries of removed owners stays bounded.
+func (pst *postings) compact() {
+	if len(pst.sorted) > 2*pst.live {
+		pst.sortEntries()
+	}
+}
+
+// sortEntries sorts entries by their keys and drops entries of removed
+// owners, if entries were changed since the last call. It is safe to call
+// by many queries holding the index read lock.
+func (pst *postings) sortEntries() {
+	pst.mu.Lock()
+	defer pst.mu.Unlock()
+	if !pst.dirty {
+		return
+	}
+	pst.sorted = slices.DeleteFunc(pst.sorted, func(ent entry) bool {
+		_, ok := pst.exact[ent.key][ent.owner]
+		return !ok
+	})
+	slices.SortFunc(pst.sorted, compareEntries)
+	pst.sorted = slices.CompactFunc(pst.sorted, func(a, b entry) bool {
+		return compareEntries(a, b) == 0
+	})
+	pst.dirty = false
+}
+
+// elemKeys returns index keys of the tag value, or slice tag elements.
+// Elements which cannot be indexed are skipped.
+func elemKeys(tag nomix.Tag) []any {
+	knd := tag.TagKind()
+	val := reflect.ValueOf(tag.TagValue())
+	if !knd.IsSlice() {
+		if key, ok := elemKey(knd, val); ok {
+			return []any{key}
+		}
+		return nil
+	}
+	if val.Kind() != reflect.Slice {
+		return nil
+	}
+	keys := make([]any, 0, val.Len())
+	for i := range val.Len() {
+		if key, ok := elemKey(knd, val.Index(i)); ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// elemKey returns the index key for the value of the given kind. Keys are
+// int64, float64, bool, string or [time.Time] in UTC. UUIDs are keyed by
+// their string representation.
+//
+// nolint: cyclop
+func elemKey(knd nomix.Kind, val reflect.Value) (any, bool) {
+	switch knd.Base() {
+	case nomix.KindInt64:
+		switch val.Kind() {
+		case reflect.Bool:
+			return val.Bool(), true
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+			reflect.Int64:
+			return val.Int(), true
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+			reflect.Uint64:
+			if val.Uint() > math.MaxInt64 {
+				return float64(val.Uint()), true
+			}
+			return int64(val.Uint()), true
+		}
+
+	case nomix.KindFloat64:
+		if val.CanFloat() {
+			return val.Float(), true
+		}
+
+	case nomix.KindString:
+		if val.Kind() == reflect.String {
+			return val.String(), true
+		}
+
+	case nomix.KindTime:
+		if v, ok := val.Interface().(time.Time); ok {
+			return v.UTC(), true
+		}
+
+	case nomix.KindUUID:
+		if v, ok := val.Interface().([16]byte); ok {
+			return xtag.FormatUUID(v), true
+		}
+	}
+	return nil, false
+}
+
+// compareEntries compares entries by their keys and owners.
+func compareEntries(a, b entry) int {
+	if res := compareKeys(a.key, b.key); res != 0 {
+		return res
+	}
+	return strings.Compare(a.owner, b.owner)
+}
+
+// compareKeys compares index keys of the same kind. Integer and float keys
+// are compared numerically.
+func compareKeys(a, b any) int {
+	switch v := a.(type) {
+	case int64:
+		switch w := b.(type) {
+		case int64:
+			return cmp.Compare(v, w)
+		case float64:
+			return cmp.Compare(float64(v), w)
+		}
+	case float64:
+		switch w := b.(type) {
+		case int64:
+			return cmp.Compare(v, float64(w))
+		case float64:
+			return cmp.Compare(v, w)
+		}
+	case string:
+		if w, ok := b.(string); ok {
+			return strings.Compare(v, w)
+		}
+	case time.Time:
+		if w, ok := b.(time.Time); ok {
+			return v.Compare(w)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package tagindex
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"sort"
+
+	"github.com/ctx42/nomix/pkg/filter"
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+)
+
+// query returns owners with tags matching the expression. It must be called
+// with the read lock held.
+func (idx *Index) query(expr filter.Expr) (ownerSet, error) {
+	switch e := expr.(type) {
+	case *filter.And:
+		left, err := idx.query(e.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := idx.query(e.Right)
+		if err != nil {
+			return nil, err
+		}
+		maps.DeleteFunc(left, func(owner string, _ struct{}) bool {
+			_, ok := right[owner]
+			return !ok
+		})
+		return left, nil
+
+	case *filter.Or:
+		left, err := idx.query(e.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := idx.query(e.Right)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(left, right)
+		return left, nil
+
+	case *filter.Not:
+		matched, err := idx.query(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		owners := make(ownerSet, len(idx.sets))
+		for owner := range idx.sets {
+			if _, ok := matched[owner]; !ok {
+				owners[owner] = struct{}{}
+			}
+		}
+		return owners, nil
+
+	case *filter.Exists:
+		owners := make(ownerSet)
+		for _, pst := range idx.names[e.Name] {
+			maps.Copy(owners, pst.owners)
+		}
+		return owners, nil
+
+	case *filter.Compare:
+		return idx.match(e.Name, e.Op.String(), e.Op, []any{e.Value})
+
+	case *filter.In:
+		return idx.match(e.Name, "IN", filter.OpEq, e.Values)
+
+	case *filter.Contains:
+		for knd := range idx.names[e.Name] {
+			if !knd.IsSlice() {
+				const format = "%s: %w: CONTAINS for %s"
+				err := filter.ErrNotSupported
+				return nil, fmt.Errorf(format, e.Name, err, knd)
+			}
+		}
+		return idx.match(e.Name, "CONTAINS", filter.OpEq, []any{e.Value})
+	}
+	return nil, fmt.Errorf("%w: %T expression", nomix.ErrNotImpl, expr)
+}
+
+// match returns owners with the named tag having any element matching any
+// of the values using the operator. The operator name is used in error
+// messages.
+func (idx *Index) match(
+	name string,
+	opName string,
+	op filter.Op,
+	vals []any,
+) (ownerSet, error) {
+
+	owners := make(ownerSet)
+	for knd, pst := range idx.names[name] {
+		for _, val := range vals {
+			key, err := queryKey(knd, op, val)
+			if err != nil {
+				if errors.Is(err, filter.ErrNotSupported) {
+					const format = "%s: %w: %s for %s"
+					return nil, fmt.Errorf(format, name, err, opName, knd)
+				}
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			pst.match(owners, op, key)
+		}
+	}
+	return owners, nil
+}
+
+// match adds owners with any element matching the key using the operator.
+func (pst *postings) match(owners ownerSet, op filter.Op, key any) {
+	switch {
+	case op == filter.OpEq:
+		maps.Copy(owners, pst.exact[key])
+
+	case op == filter.OpNe:
+		for k, set := range pst.exact {
+			if k != key {
+				maps.Copy(owners, set)
+			}
+		}
+
+	case ranged(pst.knd):
+		pst.sortEntries()
+		n := len(pst.sorted)
+		var from, to int
+		switch op {
+		case filter.OpLt, filter.OpLe:
+			to = sort.Search(n, func(i int) bool {
+				res := compareKeys(pst.sorted[i].key, key)
+				return res > 0 || res == 0 && op == filter.OpLt
+			})
+		default:
+			from = sort.Search(n, func(i int) bool {
+				res := compareKeys(pst.sorted[i].key, key)
+				return res > 0 || res == 0 && op == filter.OpGe
+			})
+			to = n
+		}
+		for _, ent := range pst.sorted[from:to] {
+			owners[ent.owner] = struct{}{}
+		}
+
+	default:
+		for k, set := range pst.exact {
+			res := compareKeys(k, key)
+			if op == filter.OpLt && res < 0 || op == filter.OpLe && res <= 0 ||
+				op == filter.OpGt && res > 0 || op == filter.OpGe && res >= 0 {
+				maps.Copy(owners, set)
+			}
+		}
+	}
+}
+
+// queryKey converts the filter value to the index key for tags of the given
+// kind. Returns [filter.ErrNotSupported] if the operator is not supported
+// for the kind.
+//
+// nolint: cyclop
+func queryKey(knd nomix.Kind, op filter.Op, val any) (any, error) {
+	equality := op == filter.OpEq || op == filter.OpNe
+	switch knd.Base() {
+	case nomix.KindInt64:
+		if knd&^nomix.KindSlice == nomix.KindBool {
+			if v, ok := val.(bool); ok {
+				if !equality {
+					return nil, filter.ErrNotSupported
+				}
+				return v, nil
+			}
+			break
+		}
+		switch v := val.(type) {
+		case int64:
+			return v, nil
+		case float64:
+			if v == math.Trunc(v) && math.Abs(v) < math.MaxInt64 {
+				return int64(v), nil
+			}
+			return v, nil
+		}
+
+	case nomix.KindFloat64:
+		switch v := val.(type) {
+		case int64:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		}
+
+	case nomix.KindString:
+		if v, ok := val.(string); ok {
+			return v, nil
+		}
+
+	case nomix.KindTime:
+		if v, ok := val.(string); ok {
+			tim, err := nomix.ParseTime(v, nomix.NewOptions())
+			if err != nil {
+				return nil, err
+			}
+			return tim.UTC(), nil
+		}
+
+	case nomix.KindUUID:
+		if v, ok := val.(string); ok {
+			if !equality {
+				return nil, filter.ErrNotSupported
+			}
+			tag, err := xtag.ParseUUID("", v)
+			if err != nil {
+				return nil, nomix.ErrInvFormat
+			}
+			return xtag.FormatUUID(tag.Get()), nil
+		}
+
+	default:
+		return nil, filter.ErrNotSupported
+	}
+	return nil, fmt.Errorf("%w: %T value", nomix.ErrInvType, val)
+}
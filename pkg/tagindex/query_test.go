@@ -0,0 +1,333 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package tagindex
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+
+	"github.com/ctx42/nomix/pkg/filter"
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+)
+
+func Test_Index_Query_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		query string
+		exp   []string
+	}{
+		{"string eq", `env = "prod"`, []string{"a1", "a3"}},
+		{"string ne", `env != "prod"`, []string{"a2"}},
+		{"string lt", `env < "qa"`, []string{"a1", "a3"}},
+		{"string ge", `env >= "qa"`, []string{"a2"}},
+		{"int eq", `replicas = 3`, []string{"a1"}},
+		{"int eq float", `replicas = 3.0`, []string{"a1"}},
+		{"int eq fraction", `replicas = 3.5`, nil},
+		{"int ne", `replicas != 3`, []string{"a2", "a3"}},
+		{"int lt", `replicas < 3`, []string{"a2"}},
+		{"int le", `replicas <= 3`, []string{"a1", "a2"}},
+		{"int gt", `replicas > 3`, []string{"a3"}},
+		{"int ge", `replicas >= 3`, []string{"a1", "a3"}},
+		{"int gt fraction", `replicas > 2.5`, []string{"a1", "a3"}},
+		{"int le fraction", `replicas <= 2.5`, []string{"a2"}},
+		{"float eq", `load = 0.5`, []string{"a1"}},
+		{"float gt int", `load > 1`, []string{"a2"}},
+		{"bool", `canary = TRUE`, []string{"a2"}},
+		{"bool ne", `canary != TRUE`, []string{"a1"}},
+		{"time gt", `created > "2000-01-02T03:04:05Z"`, []string{"a2"}},
+		{"time le", `created <= "2000-01-02T04:04:05+01:00"`, []string{"a1"}},
+		{
+			"time eq zone",
+			`created = "2000-01-02T04:04:05+01:00"`,
+			[]string{"a1"},
+		},
+		{
+			"uuid",
+			`id = "01000000-0000-0000-0000-000000000000"`,
+			[]string{"a1"},
+		},
+		{
+			"uuid braces",
+			`id = "{01000000-0000-0000-0000-000000000000}"`,
+			[]string{"a1"},
+		},
+		{
+			"uuid no dashes",
+			`id = "01000000000000000000000000000000"`,
+			[]string{"a1"},
+		},
+		{
+			"uuid ne urn",
+			`id != "urn:uuid:01000000-0000-0000-0000-000000000000"`,
+			nil,
+		},
+		{"slice any", `zones = "eu-2"`, []string{"a1"}},
+		{"slice ne", `zones != "eu-1"`, []string{"a1", "a3"}},
+		{"slice range", `ports > 100`, []string{"a2"}},
+		{"in", `env IN ("qa", "dev")`, []string{"a2"}},
+		{"in slice", `ports IN (22, 80)`, []string{"a2", "a3"}},
+		{"not in", `env NOT IN ("qa")`, []string{"a1", "a3", "a4"}},
+		{"contains", `zones CONTAINS "us-1"`, []string{"a3"}},
+		{"exists", `EXISTS ports`, []string{"a2", "a3"}},
+		{"exists not indexed", `EXISTS doc`, []string{"a1"}},
+		{"exists missing", `EXISTS abc`, nil},
+		{"missing", `abc = 1`, nil},
+		{"not missing", `NOT abc = 1`, []string{"a1", "a2", "a3", "a4"}},
+		{"and", `env = "prod" AND replicas > 3`, []string{"a3"}},
+		{"or", `env = "qa" OR replicas > 3`, []string{"a2", "a3"}},
+		{"not", `NOT env = "prod"`, []string{"a2", "a4"}},
+		{
+			"nested",
+			`(EXISTS zones OR EXISTS ports) AND NOT replicas < 2`,
+			[]string{"a1", "a3"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			idx := tstIndex()
+			expr := filter.MustParse(tc.query)
+
+			// --- When ---
+			have, err := idx.Query(expr)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+
+			// Results must be the same as evaluating each set.
+			var exp []string
+			for _, owner := range idx.Owners() {
+				set, _ := idx.Get(owner)
+				ok, err := expr.Eval(set)
+				assert.NoError(t, err)
+				if ok {
+					exp = append(exp, owner)
+				}
+			}
+			assert.Equal(t, exp, have)
+		})
+	}
+}
+
+func Test_Index_Query_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		query string
+		exp   string
+	}{
+		{
+			"type mismatch",
+			`env = 1`,
+			"env: invalid element type: int64 value",
+		},
+		{
+			"bool with int",
+			`canary = 1`,
+			"canary: invalid element type: int64 value",
+		},
+		{
+			"bool ordering",
+			`canary < TRUE`,
+			"canary: operator not supported: < for KindBool",
+		},
+		{
+			"uuid ordering",
+			`id > "a"`,
+			"id: operator not supported: > for KindUUID",
+		},
+		{
+			"invalid time",
+			`created = "abc"`,
+			"created: invalid element format",
+		},
+		{
+			"invalid uuid",
+			`id = "abc"`,
+			"id: invalid element format",
+		},
+		{
+			"json",
+			`doc = "a"`,
+			"doc: operator not supported: = for KindJSON",
+		},
+		{
+			"in",
+			`env IN ("a", 1)`,
+			"env: invalid element type: int64 value",
+		},
+		{
+			"contains single value",
+			`env CONTAINS "prod"`,
+			"env: operator not supported: CONTAINS for KindString",
+		},
+		{
+			"and left",
+			`env = 1 AND EXISTS env`,
+			"env: invalid element type: int64 value",
+		},
+		{
+			"and right",
+			`EXISTS env AND env = 1`,
+			"env: invalid element type: int64 value",
+		},
+		{
+			"or left",
+			`env = 1 OR EXISTS env`,
+			"env: invalid element type: int64 value",
+		},
+		{
+			"or right",
+			`EXISTS env OR env = 1`,
+			"env: invalid element type: int64 value",
+		},
+		{
+			"not",
+			`NOT env = 1`,
+			"env: invalid element type: int64 value",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			idx := tstIndex()
+
+			// --- When ---
+			have, err := idx.Query(filter.MustParse(tc.query))
+
+			// --- Then ---
+			assert.ErrorContain(t, tc.exp, err)
+			assert.Nil(t, have)
+		})
+	}
+}
+
+func Test_Index_Query(t *testing.T) {
+	t.Run("error - not implemented expression", func(t *testing.T) {
+		// --- Given ---
+		idx := tstIndex()
+
+		// --- When ---
+		have, err := idx.Query(nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrNotImpl, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("uint values", func(t *testing.T) {
+		// --- Given ---
+		idx := New()
+		tag := nomix.NewSingle(
+			"n", uint64(1<<63), nomix.KindInt64, nil, nil, nil,
+		)
+		idx.Set("o1", tstTagSet(tag))
+		idx.Set("o2", tstTagSet(xtag.NewInt64("n", 1)))
+
+		// --- When ---
+		have, err := idx.Query(filter.MustParse("n > 2"))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"o1"}, have)
+	})
+
+	t.Run("duplicated slice elements", func(t *testing.T) {
+		// --- Given ---
+		idx := New()
+		idx.Set("o1", tstTagSet(xtag.NewIntSlice("n", 1, 1)))
+
+		// --- When ---
+		idx.Remove("o1")
+
+		// --- Then ---
+		have, err := idx.Query(filter.MustParse("n >= 0"))
+		assert.NoError(t, err)
+		assert.Nil(t, have)
+	})
+	t.Run("range after changes", func(t *testing.T) {
+		// --- Given ---
+		idx := New()
+		idx.Set("o1", tstTagSet(xtag.NewInt("n", 3)))
+		idx.Set("o2", tstTagSet(xtag.NewInt("n", 1)))
+		idx.Set("o1", tstTagSet(xtag.NewInt("n", 2)))
+		idx.Set("o3", tstTagSet(xtag.NewInt("n", 3)))
+		idx.Remove("o2")
+
+		// --- When ---
+		have, err := idx.Query(filter.MustParse("n >= 2"))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"o1", "o3"}, have)
+		pst := idx.names["n"][nomix.KindInt]
+		assert.False(t, pst.dirty)
+		want := []entry{{int64(2), "o1"}, {int64(3), "o3"}}
+		assert.Equal(t, want, pst.sorted)
+	})
+
+	t.Run("stale entries are compacted", func(t *testing.T) {
+		// --- Given ---
+		idx := New()
+		idx.Set("o1", tstTagSet(xtag.NewInt("n", 1)))
+
+		// --- When ---
+		for i := range 10 {
+			idx.Set("o1", tstTagSet(xtag.NewInt("n", i)))
+		}
+
+		// --- Then ---
+		pst := idx.names["n"][nomix.KindInt]
+		assert.Equal(t, 1, pst.live)
+		assert.True(t, len(pst.sorted) <= 2)
+	})
+}
+
+func Benchmark_Index_Query(b *testing.B) {
+	const owners = 200_000
+
+	b.Run("set", func(b *testing.B) {
+		for b.Loop() {
+			idx := New()
+			for i := range owners {
+				set := tstTagSet(xtag.NewInt("n", i))
+				idx.Set("o"+strconv.Itoa(i), set)
+			}
+		}
+	})
+
+	b.Run("set and range query", func(b *testing.B) {
+		for b.Loop() {
+			idx := New()
+			for i := range owners {
+				set := tstTagSet(xtag.NewInt("n", i))
+				idx.Set("o"+strconv.Itoa(i), set)
+			}
+			if _, err := idx.Query(filter.MustParse("n < 100")); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("range query", func(b *testing.B) {
+		idx := New()
+		for i := range owners {
+			idx.Set("o"+strconv.Itoa(i), tstTagSet(xtag.NewInt("n", i)))
+		}
+		expr := filter.MustParse("n < 100")
+
+		for b.Loop() {
+			if _, err := idx.Query(expr); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
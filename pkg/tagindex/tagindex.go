@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+// Package tagindex provides the in-memory inverted index over many tag sets.
+//
+// The index maps owner IDs to their tag sets and, for each tag name and
+// kind, maintains postings mapping tag values to owners. String, boolean,
+// integer, float, time and UUID values are indexed for exact matches, and
+// values of the [nomix.KindInt64], [nomix.KindFloat64] and [nomix.KindTime]
+// base kinds are also kept sorted for range queries. Slice tags are indexed
+// by each of their elements. JSON and byte slice values are not indexed,
+// but the index still knows which owners have them.
+package tagindex
+
+import (
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/ctx42/nomix/pkg/filter"
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+// Index represents the inverted index over many tag sets. It is safe for
+// concurrent use.
+type Index struct {
+	mu    sync.RWMutex
+	sets  map[string]nomix.TagSet             // Tag sets by owner.
+	names map[string]map[nomix.Kind]*postings // Postings by tag name.
+}
+
+// New returns a new empty instance of [Index].
+func New() *Index {
+	return &Index{
+		sets:  make(map[string]nomix.TagSet),
+		names: make(map[string]map[nomix.Kind]*postings),
+	}
+}
+
+// Set adds, or replaces, the owner's tags in the index. The index keeps its
+// own tag set holding the same tags, so the tags must not be modified
+// after they are added, call Set again to update them.
+func (idx *Index) Set(owner string, tags nomix.AllGetter) {
+	set := nomix.NewTagSet()
+	for _, tag := range tags.TagGetAll() {
+		set.TagSet(tag)
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(owner)
+	idx.sets[owner] = set
+	for name, tag := range set.TagGetAll() {
+		byKind, ok := idx.names[name]
+		if !ok {
+			byKind = make(map[nomix.Kind]*postings)
+			idx.names[name] = byKind
+		}
+		pst, ok := byKind[tag.TagKind()]
+		if !ok {
+			pst = newPostings(tag.TagKind())
+			byKind[tag.TagKind()] = pst
+		}
+		pst.add(owner, tag)
+	}
+}
+
+// Remove removes the owner's tags from the index.
+func (idx *Index) Remove(owner string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(owner)
+}
+
+// remove removes the owner's tags from the index. It must be called with
+// the write lock held.
+func (idx *Index) remove(owner string) {
+	set, ok := idx.sets[owner]
+	if !ok {
+		return
+	}
+	delete(idx.sets, owner)
+	for name, tag := range set.TagGetAll() {
+		byKind := idx.names[name]
+		pst := byKind[tag.TagKind()]
+		if pst.remove(owner, tag) {
+			continue
+		}
+		delete(byKind, tag.TagKind())
+		if len(byKind) == 0 {
+			delete(idx.names, name)
+		}
+	}
+}
+
+// Get returns the owner's tags. Returns false if the owner is not in the
+// index. The returned set must not be modified.
+func (idx *Index) Get(owner string) (nomix.TagSet, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	set, ok := idx.sets[owner]
+	return set, ok
+}
+
+// Len returns the number of owners in the index.
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.sets)
+}
+
+// Owners returns sorted IDs of all owners in the index.
+func (idx *Index) Owners() []string {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return slices.Sorted(maps.Keys(idx.sets))
+}
+
+// Query returns sorted IDs of owners with tags matching the filter
+// expression. The result is the same as evaluating the expression with
+// [filter.Expr.Eval] against each of the tag sets, except that both sides
+// of logical operators are always evaluated, so errors are returned even
+// when the other side decides the result.
+//
+// Returns an error if the operator is not supported for the kind of any of
+// the indexed tags, or the value cannot be compared with them.
+func (idx *Index) Query(expr filter.Expr) ([]string, error) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	owners, err := idx.query(expr)
+	if err != nil {
+		return nil, err
+	}
+	return slices.Sorted(maps.Keys(owners)), nil
+}
@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package tagindex
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+
+	"github.com/ctx42/nomix/pkg/filter"
+	"github.com/ctx42/nomix/pkg/nomix"
+	"github.com/ctx42/nomix/pkg/xtag"
+)
+
+// tstTagSet returns a tag set with the given tags.
+func tstTagSet(tags ...nomix.Tag) nomix.TagSet {
+	set := nomix.NewTagSet()
+	set.TagSet(tags...)
+	return set
+}
+
+// tstIndex returns the index used in tests.
+func tstIndex() *Index {
+	tim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	idx := New()
+	idx.Set("a1", tstTagSet(
+		xtag.NewString("env", "prod"),
+		xtag.NewInt("replicas", 3),
+		xtag.NewFloat64("load", 0.5),
+		xtag.NewBool("canary", false),
+		xtag.NewTime("created", tim),
+		xtag.NewUUID("id", [16]byte{1}),
+		xtag.NewStringSlice("zones", "eu-1", "eu-2"),
+		xtag.NewJSON("doc", []byte(`{}`)),
+	))
+	idx.Set("a2", tstTagSet(
+		xtag.NewString("env", "qa"),
+		xtag.NewInt("replicas", 1),
+		xtag.NewFloat64("load", 1.5),
+		xtag.NewBool("canary", true),
+		xtag.NewTime("created", tim.Add(time.Hour)),
+		xtag.NewIntSlice("ports", 80, 443),
+	))
+	idx.Set("a3", tstTagSet(
+		xtag.NewString("env", "prod"),
+		xtag.NewInt("replicas", 5),
+		xtag.NewStringSlice("zones", "us-1"),
+		xtag.NewIntSlice("ports", 22),
+	))
+	idx.Set("a4", tstTagSet())
+	return idx
+}
+
+func Test_New(t *testing.T) {
+	// --- When ---
+	have := New()
+
+	// --- Then ---
+	assert.Equal(t, 0, have.Len())
+	assert.NotNil(t, have.sets)
+	assert.NotNil(t, have.names)
+}
+
+func Test_Index_Set(t *testing.T) {
+	t.Run("add", func(t *testing.T) {
+		// --- Given ---
+		idx := New()
+		set := tstTagSet(xtag.NewInt("A", 1), xtag.NewString("B", "b"))
+
+		// --- When ---
+		idx.Set("o1", set)
+
+		// --- Then ---
+		assert.Equal(t, 1, idx.Len())
+		have, ok := idx.Get("o1")
+		assert.True(t, ok)
+		assert.Equal(t, set, have)
+		assert.Len(t, 2, idx.names)
+	})
+
+	t.Run("keeps its own set", func(t *testing.T) {
+		// --- Given ---
+		idx := New()
+		set := tstTagSet(xtag.NewInt("A", 1))
+
+		// --- When ---
+		idx.Set("o1", set)
+
+		// --- Then ---
+		set.TagSet(xtag.NewInt("B", 2))
+		have, _ := idx.Get("o1")
+		assert.Equal(t, 1, have.TagCount())
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		// --- Given ---
+		idx := New()
+		idx.Set("o1", tstTagSet(xtag.NewInt("A", 1), xtag.NewInt("B", 2)))
+
+		// --- When ---
+		idx.Set("o1", tstTagSet(xtag.NewInt("A", 3)))
+
+		// --- Then ---
+		assert.Equal(t, 1, idx.Len())
+		have, err := idx.Query(filter.MustParse("A = 1 OR A = 3 OR EXISTS B"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"o1"}, have)
+		assert.Len(t, 1, idx.names)
+		assert.Len(t, 1, idx.names["A"][nomix.KindInt].exact)
+		assert.Equal(t, 1, idx.names["A"][nomix.KindInt].live)
+	})
+
+	t.Run("replace with other kind", func(t *testing.T) {
+		// --- Given ---
+		idx := New()
+		idx.Set("o1", tstTagSet(xtag.NewInt("A", 1)))
+
+		// --- When ---
+		idx.Set("o1", tstTagSet(xtag.NewString("A", "1")))
+
+		// --- Then ---
+		assert.Len(t, 1, idx.names["A"])
+		_, ok := idx.names["A"][nomix.KindString]
+		assert.True(t, ok)
+	})
+}
+
+func Test_Index_Remove(t *testing.T) {
+	t.Run("remove", func(t *testing.T) {
+		// --- Given ---
+		idx := tstIndex()
+
+		// --- When ---
+		idx.Remove("a1")
+
+		// --- Then ---
+		assert.Equal(t, 3, idx.Len())
+		_, ok := idx.Get("a1")
+		assert.False(t, ok)
+		have, err := idx.Query(filter.MustParse(`env = "prod"`))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a3"}, have)
+		_, ok = idx.names["doc"]
+		assert.False(t, ok)
+	})
+
+	t.Run("all", func(t *testing.T) {
+		// --- Given ---
+		idx := tstIndex()
+
+		// --- When ---
+		for _, owner := range idx.Owners() {
+			idx.Remove(owner)
+		}
+
+		// --- Then ---
+		assert.Equal(t, 0, idx.Len())
+		assert.Len(t, 0, idx.names)
+	})
+
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		idx := tstIndex()
+
+		// --- When ---
+		idx.Remove("abc")
+
+		// --- Then ---
+		assert.Equal(t, 4, idx.Len())
+	})
+}
+
+func Test_Index_Get(t *testing.T) {
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		idx := tstIndex()
+
+		// --- When ---
+		have, ok := idx.Get("abc")
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Zero(t, have)
+	})
+}
+
+func Test_Index_Owners(t *testing.T) {
+	// --- Given ---
+	idx := tstIndex()
+
+	// --- When ---
+	have := idx.Owners()
+
+	// --- Then ---
+	assert.Equal(t, []string{"a1", "a2", "a3", "a4"}, have)
+}
+
+func Test_Index_concurrent(t *testing.T) {
+	// --- Given ---
+	idx := New()
+	expr := filter.MustParse("n >= 0")
+	wg := sync.WaitGroup{}
+
+	// --- When ---
+	for i := range 10 {
+		wg.Go(func() {
+			for j := range 100 {
+				owner := strconv.Itoa(i*100 + j)
+				idx.Set(owner, tstTagSet(xtag.NewInt("n", j)))
+				if _, err := idx.Query(expr); err != nil {
+					t.Error(err)
+				}
+				if j%2 == 0 {
+					idx.Remove(owner)
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	// --- Then ---
+	have, err := idx.Query(expr)
+	assert.NoError(t, err)
+	assert.Len(t, 500, have)
+}
@@ -32,6 +32,7 @@ func NewBool(name string, val bool) *Bool {
 		nomix.KindBool,
 		strconv.FormatBool,
 		sqlValueBool,
+		sqlScanBool,
 	)
 }
 
@@ -62,3 +63,22 @@ func sqlValueBool(val bool) (driver.Value, error) {
 	}
 	return int64(0), nil
 }
+
+// sqlScanBool converts the database value to bool according to
+// [nomix.KindBool] base type. Any non-zero int64 value is true. Returns
+// [nomix.ErrInvType] if the value's type is not int64, bool, []byte or
+// string, and [nomix.ErrInvFormat] if the textual value is not a valid
+// boolean.
+func sqlScanBool(src any) (bool, error) {
+	switch v := src.(type) {
+	case int64:
+		return v != 0, nil
+	case bool:
+		return v, nil
+	case []byte:
+		return sqlScanBool(string(v))
+	case string:
+		return parseBoolElem(v)
+	}
+	return false, nomix.ErrInvType
+}
@@ -17,7 +17,7 @@ type BoolSlice = nomix.Slice[bool]
 var boolSliceSpec = nomix.NewKindSpec(
 	nomix.KindBoolSlice,
 	nomix.TagCreateFunc(CreateBoolSlice),
-	nomix.TagParserNotImpl,
+	nomix.TagParseFunc(ParseBoolSlice),
 )
 
 // BoolSliceSpec returns a [nomix.KindSpec] for [BoolSlice] type.
@@ -25,12 +25,20 @@ func BoolSliceSpec() nomix.KindSpec { return boolSliceSpec }
 
 // NewBoolSlice returns a new instance of [BoolSlice].
 func NewBoolSlice(name string, val ...bool) *BoolSlice {
+	return newBoolSlice(name, val, nomix.NewOptions())
+}
+
+// newBoolSlice returns a new instance of [BoolSlice] using
+// [nomix.Options.SliceEncoder] for the database representation.
+func newBoolSlice(name string, val []bool, def nomix.Options) *BoolSlice {
+	valuer, scanner := sliceSQL(def, false, sqlElemBool, parseBoolElem)
 	return nomix.NewSlice(
 		name,
 		val,
 		nomix.KindBoolSlice,
 		strValueBoolSlice,
-		nil,
+		valuer,
+		scanner,
 	)
 }
 
@@ -40,15 +48,43 @@ func NewBoolSlice(name string, val ...bool) *BoolSlice {
 func CreateBoolSlice(
 	name string,
 	val any,
-	_ ...nomix.Option,
+	opts ...nomix.Option,
 ) (*BoolSlice, error) {
 
+	def := nomix.NewOptions(opts...)
 	if v, ok := val.([]bool); ok {
-		return NewBoolSlice(name, v...), nil
+		return newBoolSlice(name, v, def), nil
 	}
 	return nil, fmt.Errorf("%s: %w", name, nomix.ErrInvType)
 }
 
+// ParseBoolSlice parses a string representation of the bool slice tag. Both
+// the JSON-array form, as returned by the String method, and the delimited
+// form are supported. Use [nomix.WithSeparator], [nomix.WithQuote] and
+// [nomix.WithNoTrim] options to configure the delimited form.
+func ParseBoolSlice(
+	name string,
+	val string,
+	opts ...nomix.Option,
+) (*BoolSlice, error) {
+
+	def := nomix.NewOptions(opts...)
+	v, err := parseSlice(val, def, parseBoolElem)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return newBoolSlice(name, v, def), nil
+}
+
+// parseBoolElem parses string representation of bool slice element.
+func parseBoolElem(val string) (bool, error) {
+	v, err := strconv.ParseBool(val)
+	if err != nil {
+		return false, nomix.ErrInvFormat
+	}
+	return v, nil
+}
+
 // strValueBoolSlice converts a bool slice to its string representation.
 func strValueBoolSlice(v []bool) string {
 	ret := "["
@@ -60,3 +96,12 @@ func strValueBoolSlice(v []bool) string {
 	}
 	return ret + "]"
 }
+
+// sqlElemBool converts bool to its database representation according to
+// [nomix.KindBool] base type.
+func sqlElemBool(v bool) string {
+	if v {
+		return "1"
+	}
+	return "0"
+}
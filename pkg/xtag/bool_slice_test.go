@@ -24,8 +24,11 @@ func Test_BoolSliceSpec(t *testing.T) {
 	assert.Equal(t, nomix.KindBoolSlice, tag.TagKind())
 
 	tag, err = have.TagParse("name", "[true, false]")
-	assert.ErrorIs(t, nomix.ErrNotImpl, err)
-	assert.Nil(t, tag)
+	assert.NoError(t, err)
+	assert.SameType(t, &BoolSlice{}, tag)
+	assert.Equal(t, "name", tag.TagName())
+	assert.Equal(t, []bool{true, false}, tag.TagValue())
+	assert.Equal(t, nomix.KindBoolSlice, tag.TagKind())
 }
 
 func Test_NewBoolSlice(t *testing.T) {
@@ -73,3 +76,116 @@ func Test_CreateBoolSlice(t *testing.T) {
 		assert.Nil(t, have)
 	})
 }
+
+func Test_ParseBoolSlice(t *testing.T) {
+	t.Run("json form", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseBoolSlice("name", "[true, false]")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.SameType(t, &BoolSlice{}, tag)
+		assert.Equal(t, "name", tag.TagName())
+		assert.Equal(t, []bool{true, false}, tag.TagValue())
+		assert.Equal(t, nomix.KindBoolSlice, tag.TagKind())
+	})
+
+	t.Run("delimited form", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseBoolSlice("name", "1,0,t")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []bool{true, false, true}, tag.TagValue())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		want := NewBoolSlice("name", []bool{true, false}...)
+
+		// --- When ---
+		have, err := ParseBoolSlice("name", want.String())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, want.TagValue(), have.TagValue())
+	})
+
+	t.Run("error - invalid element", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseBoolSlice("name", "true,abc")
+
+		// --- Then ---
+		assert.ErrorEqual(t, "name: invalid element format", err)
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, tag)
+	})
+}
+
+func Test_BoolSlice_Value(t *testing.T) {
+	t.Run("default encoder", func(t *testing.T) {
+		// --- Given ---
+		tag := NewBoolSlice("name", true, false)
+
+		// --- When ---
+		have, err := tag.Value()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `[1,0]`, have)
+	})
+
+	t.Run("custom encoder", func(t *testing.T) {
+		// --- Given ---
+		opt := nomix.WithSliceEncoder(nomix.PGArray{})
+		tag, err := CreateBoolSlice("name", []bool{true, false}, opt)
+		assert.NoError(t, err)
+
+		// --- When ---
+		have, err := tag.Value()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{1,0}`, have)
+	})
+}
+
+func Test_BoolSlice_Scan(t *testing.T) {
+	t.Run("default encoder", func(t *testing.T) {
+		// --- Given ---
+		tag := NewBoolSlice("name")
+
+		// --- When ---
+		err := tag.Scan([]byte(`[1,0]`))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []bool{true, false}, tag.Get())
+	})
+
+	t.Run("custom encoder", func(t *testing.T) {
+		// --- Given ---
+		opt := nomix.WithSliceEncoder(nomix.PGArray{})
+		tag, err := CreateBoolSlice("name", []bool{true, false}[:0], opt)
+		assert.NoError(t, err)
+
+		// --- When ---
+		err = tag.Scan(`{1,0}`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []bool{true, false}, tag.Get())
+	})
+
+	t.Run("error - invalid format", func(t *testing.T) {
+		// --- Given ---
+		tag := NewBoolSlice("name")
+
+		// --- When ---
+		err := tag.Scan(`[1, abc]`)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.ErrorContain(t, "name: ", err)
+	})
+}
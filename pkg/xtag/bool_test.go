@@ -164,3 +164,51 @@ func Test_sqlValueBool_tabular(t *testing.T) {
 		})
 	}
 }
+
+func Test_sqlScanBool_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		src any
+		exp bool
+	}{
+		{"int64 one", int64(1), true},
+		{"int64 zero", int64(0), false},
+		{"int64 other", int64(-2), true},
+		{"bool", true, true},
+		{"string", "true", true},
+		{"string number", "0", false},
+		{"byte slice", []byte("1"), true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := sqlScanBool(tc.src)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_sqlScanBool(t *testing.T) {
+	t.Run("error - invalid format", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanBool("abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.False(t, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanBool(1.0)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.False(t, have)
+	})
+}
@@ -4,6 +4,7 @@
 package xtag
 
 import (
+	"bytes"
 	"fmt"
 	"strconv"
 
@@ -17,7 +18,7 @@ type ByteSlice = nomix.Slice[byte]
 var byteSliceSpec = nomix.NewKindSpec(
 	nomix.KindByteSlice,
 	nomix.TagCreateFunc(CreateByteSlice),
-	nomix.TagParserNotImpl,
+	nomix.TagParseFunc(ParseByteSlice),
 )
 
 // ByteSliceSpec returns a [nomix.KindSpec] for [ByteSlice] type.
@@ -31,6 +32,7 @@ func NewByteSlice(name string, val ...byte) *ByteSlice {
 		nomix.KindByteSlice,
 		strValueByteSlice,
 		nil,
+		sqlScanByteSlice,
 	)
 }
 
@@ -49,6 +51,33 @@ func CreateByteSlice(
 	return nil, fmt.Errorf("%s: %w", name, nomix.ErrInvType)
 }
 
+// ParseByteSlice parses a string representation of the byte slice tag. Both
+// the JSON-array form, as returned by the String method, and the delimited
+// form are supported. Use [nomix.WithSeparator], [nomix.WithQuote] and
+// [nomix.WithNoTrim] options to configure the delimited form. The
+// [nomix.Options.Radix] applies only to the delimited form, the JSON-array
+// form is always parsed in base 10.
+func ParseByteSlice(
+	name string,
+	val string,
+	opts ...nomix.Option,
+) (*ByteSlice, error) {
+
+	def := nomix.NewOptions(opts...)
+	radix := sliceRadix(val, def)
+	v, err := parseSlice(val, def, func(s string) (byte, error) {
+		v, err := strconv.ParseUint(s, radix, 8)
+		if err != nil {
+			return 0, nomix.ErrInvFormat
+		}
+		return byte(v), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return NewByteSlice(name, v...), nil
+}
+
 // strValueByteSlice converts a byte slice to its string representation.
 func strValueByteSlice(v []byte) string {
 	ret := "["
@@ -60,3 +89,16 @@ func strValueByteSlice(v []byte) string {
 	}
 	return ret + "]"
 }
+
+// sqlScanByteSlice converts the database value to []byte. The []byte values
+// are copied, since the database driver may reuse the buffer. Returns
+// [nomix.ErrInvType] if the value's type is not []byte or string.
+func sqlScanByteSlice(src any) ([]byte, error) {
+	switch v := src.(type) {
+	case []byte:
+		return bytes.Clone(v), nil
+	case string:
+		return []byte(v), nil
+	}
+	return nil, nomix.ErrInvType
+}
@@ -24,8 +24,11 @@ func Test_ByteSliceSpec(t *testing.T) {
 	assert.Equal(t, nomix.KindByteSlice, tag.TagKind())
 
 	tag, err = have.TagParse("name", "[42, 44]")
-	assert.ErrorIs(t, nomix.ErrNotImpl, err)
-	assert.Nil(t, tag)
+	assert.NoError(t, err)
+	assert.SameType(t, &ByteSlice{}, tag)
+	assert.Equal(t, "name", tag.TagName())
+	assert.Equal(t, []byte{42, 44}, tag.TagValue())
+	assert.Equal(t, nomix.KindByteSlice, tag.TagKind())
 }
 
 func Test_NewByteSlice(t *testing.T) {
@@ -73,3 +76,94 @@ func Test_CreateByteSlice(t *testing.T) {
 		assert.Nil(t, have)
 	})
 }
+
+func Test_ParseByteSlice(t *testing.T) {
+	t.Run("json form", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseByteSlice("name", "[42, 44]")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.SameType(t, &ByteSlice{}, tag)
+		assert.Equal(t, "name", tag.TagName())
+		assert.Equal(t, []byte{42, 44}, tag.TagValue())
+		assert.Equal(t, nomix.KindByteSlice, tag.TagKind())
+	})
+
+	t.Run("delimited form", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseByteSlice("name", "ff,0a", nomix.WithRadixHEX)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{255, 10}, tag.TagValue())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		want := NewByteSlice("name", []byte{0, 1, 255}...)
+
+		// --- When ---
+		have, err := ParseByteSlice("name", want.String())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, want.TagValue(), have.TagValue())
+	})
+
+	t.Run("round trip with hex radix", func(t *testing.T) {
+		// --- Given ---
+		want := NewByteSlice("name", []byte{0, 10, 255}...)
+
+		// --- When ---
+		have, err := ParseByteSlice("name", want.String(), nomix.WithRadixHEX)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, want.TagValue(), have.TagValue())
+	})
+
+	t.Run("error - invalid element", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseByteSlice("name", "[42, 256]")
+
+		// --- Then ---
+		assert.ErrorEqual(t, "name: invalid element format", err)
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, tag)
+	})
+}
+
+func Test_sqlScanByteSlice(t *testing.T) {
+	t.Run("byte slice is copied", func(t *testing.T) {
+		// --- Given ---
+		src := []byte{1, 2}
+
+		// --- When ---
+		have, err := sqlScanByteSlice(src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{1, 2}, have)
+		src[0] = 3
+		assert.Equal(t, []byte{1, 2}, have)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanByteSlice("ab")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("ab"), have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanByteSlice(int64(42))
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Nil(t, have)
+	})
+}
@@ -25,7 +25,14 @@ func Float64Spec() nomix.KindSpec { return float64Spec }
 
 // NewFloat64 returns a new instance of [Float64].
 func NewFloat64(name string, val float64) *Float64 {
-	return nomix.NewSingle(name, val, nomix.KindFloat64, float64ToString, nil)
+	return nomix.NewSingle(
+		name,
+		val,
+		nomix.KindFloat64,
+		float64ToString,
+		nil,
+		sqlScanFloat64,
+	)
 }
 
 // CreateFloat64 casts the value to float64. Returns the [Float64] instance
@@ -55,3 +62,20 @@ func ParseFloat64(name, v string, _ ...nomix.Option) (*Float64, error) {
 func float64ToString(v float64) string {
 	return strconv.FormatFloat(v, 'g', -1, 64)
 }
+
+// sqlScanFloat64 converts the database value to float64. Returns
+// [nomix.ErrInvType] if the value's type is not float64, int64, []byte or
+// string, and [nomix.ErrInvFormat] if the textual value is not a valid float.
+func sqlScanFloat64(src any) (float64, error) {
+	switch v := src.(type) {
+	case float64:
+		return v, nil
+	case int64:
+		return float64(v), nil
+	case []byte:
+		return parseFloat64Elem(string(v))
+	case string:
+		return parseFloat64Elem(v)
+	}
+	return 0, nomix.ErrInvType
+}
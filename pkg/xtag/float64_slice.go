@@ -17,7 +17,7 @@ type Float64Slice = nomix.Slice[float64]
 var float64SliceSpec = nomix.NewKindSpec(
 	nomix.KindFloat64Slice,
 	nomix.TagCreateFunc(CreateFloat64Slice),
-	nomix.TagParserNotImpl,
+	nomix.TagParseFunc(ParseFloat64Slice),
 )
 
 // Float64SliceSpec returns a [nomix.KindSpec] for [Float64Slice] type.
@@ -25,12 +25,25 @@ func Float64SliceSpec() nomix.KindSpec { return float64SliceSpec }
 
 // NewFloat64Slice returns a new instance of [Float64Slice].
 func NewFloat64Slice(name string, val ...float64) *Float64Slice {
+	return newFloat64Slice(name, val, nomix.NewOptions())
+}
+
+// newFloat64Slice returns a new instance of [Float64Slice] using
+// [nomix.Options.SliceEncoder] for the database representation.
+func newFloat64Slice(
+	name string,
+	val []float64,
+	def nomix.Options,
+) *Float64Slice {
+
+	valuer, scanner := sliceSQL(def, false, float64ToString, parseFloat64Elem)
 	return nomix.NewSlice(
 		name,
 		val,
 		nomix.KindFloat64Slice,
 		strValueFloat64Slice,
-		nil,
+		valuer,
+		scanner,
 	)
 }
 
@@ -43,14 +56,43 @@ func NewFloat64Slice(name string, val ...float64) *Float64Slice {
 func CreateFloat64Slice(
 	name string,
 	val any,
-	_ ...nomix.Option,
+	opts ...nomix.Option,
 ) (*Float64Slice, error) {
 
+	def := nomix.NewOptions(opts...)
 	v, err := nomix.CreateFloat64Slice(val)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", name, err)
 	}
-	return NewFloat64Slice(name, v...), nil
+	return newFloat64Slice(name, v, def), nil
+}
+
+// ParseFloat64Slice parses a string representation of the float64 slice tag.
+// Both the JSON-array form, as returned by the String method, and the
+// delimited form are supported. Use [nomix.WithSeparator],
+// [nomix.WithQuote] and [nomix.WithNoTrim] options to configure the
+// delimited form.
+func ParseFloat64Slice(
+	name string,
+	val string,
+	opts ...nomix.Option,
+) (*Float64Slice, error) {
+
+	def := nomix.NewOptions(opts...)
+	v, err := parseSlice(val, def, parseFloat64Elem)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return newFloat64Slice(name, v, def), nil
+}
+
+// parseFloat64Elem parses string representation of float64 slice element.
+func parseFloat64Elem(val string) (float64, error) {
+	v, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return 0, nomix.ErrInvFormat
+	}
+	return v, nil
 }
 
 // strValueFloat64Slice converts a float64 slice to its string representation.
@@ -24,8 +24,11 @@ func Test_Float64SliceSpec(t *testing.T) {
 	assert.Equal(t, nomix.KindFloat64Slice, tag.TagKind())
 
 	tag, err = have.TagParse("name", "[42.1, 44.2]")
-	assert.ErrorIs(t, nomix.ErrNotImpl, err)
-	assert.Nil(t, tag)
+	assert.NoError(t, err)
+	assert.SameType(t, &Float64Slice{}, tag)
+	assert.Equal(t, "name", tag.TagName())
+	assert.Equal(t, []float64{42.1, 44.2}, tag.TagValue())
+	assert.Equal(t, nomix.KindFloat64Slice, tag.TagKind())
 }
 
 func Test_NewFloat64Slice(t *testing.T) {
@@ -73,3 +76,116 @@ func Test_CreateFloat64Slice(t *testing.T) {
 		assert.Nil(t, have)
 	})
 }
+
+func Test_ParseFloat64Slice(t *testing.T) {
+	t.Run("json form", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseFloat64Slice("name", "[42.1, -1e+06]")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.SameType(t, &Float64Slice{}, tag)
+		assert.Equal(t, "name", tag.TagName())
+		assert.Equal(t, []float64{42.1, -1e+06}, tag.TagValue())
+		assert.Equal(t, nomix.KindFloat64Slice, tag.TagKind())
+	})
+
+	t.Run("delimited form", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseFloat64Slice("name", "42.1,44")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{42.1, 44}, tag.TagValue())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		want := NewFloat64Slice("name", []float64{1.5, 2, -3.25}...)
+
+		// --- When ---
+		have, err := ParseFloat64Slice("name", want.String())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, want.TagValue(), have.TagValue())
+	})
+
+	t.Run("error - invalid element", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseFloat64Slice("name", "[42.1, abc]")
+
+		// --- Then ---
+		assert.ErrorEqual(t, "name: invalid element format", err)
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, tag)
+	})
+}
+
+func Test_Float64Slice_Value(t *testing.T) {
+	t.Run("default encoder", func(t *testing.T) {
+		// --- Given ---
+		tag := NewFloat64Slice("name", 4.2, 1)
+
+		// --- When ---
+		have, err := tag.Value()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `[4.2,1]`, have)
+	})
+
+	t.Run("custom encoder", func(t *testing.T) {
+		// --- Given ---
+		opt := nomix.WithSliceEncoder(nomix.PGArray{})
+		tag, err := CreateFloat64Slice("name", []float64{4.2, 1}, opt)
+		assert.NoError(t, err)
+
+		// --- When ---
+		have, err := tag.Value()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{4.2,1}`, have)
+	})
+}
+
+func Test_Float64Slice_Scan(t *testing.T) {
+	t.Run("default encoder", func(t *testing.T) {
+		// --- Given ---
+		tag := NewFloat64Slice("name")
+
+		// --- When ---
+		err := tag.Scan([]byte(`[4.2,1]`))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{4.2, 1}, tag.Get())
+	})
+
+	t.Run("custom encoder", func(t *testing.T) {
+		// --- Given ---
+		opt := nomix.WithSliceEncoder(nomix.PGArray{})
+		tag, err := CreateFloat64Slice("name", []float64{4.2, 1}[:0], opt)
+		assert.NoError(t, err)
+
+		// --- When ---
+		err = tag.Scan(`{4.2,1}`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{4.2, 1}, tag.Get())
+	})
+
+	t.Run("error - invalid format", func(t *testing.T) {
+		// --- Given ---
+		tag := NewFloat64Slice("name")
+
+		// --- When ---
+		err := tag.Scan(`{4.2,abc}`)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.ErrorContain(t, "name: ", err)
+	})
+}
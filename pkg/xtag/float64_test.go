@@ -112,3 +112,48 @@ func Test_ParseFloat64(t *testing.T) {
 		assert.Nil(t, have)
 	})
 }
+
+func Test_sqlScanFloat64_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		src any
+		exp float64
+	}{
+		{"float64", 4.2, 4.2},
+		{"int64", int64(42), 42},
+		{"string", "4.2", 4.2},
+		{"byte slice", []byte("4.2"), 4.2},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := sqlScanFloat64(tc.src)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_sqlScanFloat64(t *testing.T) {
+	t.Run("error - invalid format", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanFloat64("abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Equal(t, 0.0, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanFloat64(true)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Equal(t, 0.0, have)
+	})
+}
@@ -26,7 +26,14 @@ func IntSpec() nomix.KindSpec { return intSpec }
 
 // NewInt returns a new instance of [Int].
 func NewInt(name string, val int) *Int {
-	return nomix.NewSingle(name, val, nomix.KindInt, strconv.Itoa, sqlValueInt)
+	return nomix.NewSingle(
+		name,
+		val,
+		nomix.KindInt,
+		strconv.Itoa,
+		sqlValueInt,
+		sqlScanInt,
+	)
 }
 
 // CreateInt casts the value to int. Returns the [Int] instance with the given
@@ -51,3 +58,13 @@ func ParseInt(name, val string, opts ...nomix.Option) (*Int, error) {
 
 // sqlValueInt converts int to its int64 representation. Never returns an error.
 func sqlValueInt(val int) (driver.Value, error) { return int64(val), nil }
+
+// sqlScanInt converts the database value to int. It supports the same value
+// types as [sqlScanInt64].
+func sqlScanInt(src any) (int, error) {
+	v, err := sqlScanInt64(src)
+	if err != nil {
+		return 0, err
+	}
+	return int(v), nil
+}
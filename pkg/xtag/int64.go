@@ -25,7 +25,14 @@ func Int64Spec() nomix.KindSpec { return int64Spec }
 
 // NewInt64 returns a new instance of [Int64].
 func NewInt64(name string, val int64) *Int64 {
-	return nomix.NewSingle(name, val, nomix.KindInt64, strValueInt64, nil)
+	return nomix.NewSingle(
+		name,
+		val,
+		nomix.KindInt64,
+		strValueInt64,
+		nil,
+		sqlScanInt64,
+	)
 }
 
 // CreateInt64 casts the value to int64. Returns the [Int64] instance with the
@@ -52,3 +59,22 @@ func ParseInt64(name, v string, opts ...nomix.Option) (*Int64, error) {
 
 // strValueInt64 converts int64 to its string representation.
 func strValueInt64(v int64) string { return strconv.FormatInt(v, 10) }
+
+// sqlScanInt64 converts the database value to int64. Returns
+// [nomix.ErrInvType] if the value's type is not int64, []byte or string, and
+// [nomix.ErrInvFormat] if the textual value is not a valid integer.
+func sqlScanInt64(src any) (int64, error) {
+	switch v := src.(type) {
+	case int64:
+		return v, nil
+	case []byte:
+		return sqlScanInt64(string(v))
+	case string:
+		val, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, nomix.ErrInvFormat
+		}
+		return val, nil
+	}
+	return 0, nomix.ErrInvType
+}
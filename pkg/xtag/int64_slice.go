@@ -17,7 +17,7 @@ type Int64Slice = nomix.Slice[int64]
 var int64SliceSpec = nomix.NewKindSpec(
 	nomix.KindInt64Slice,
 	nomix.TagCreateFunc(CreateInt64Slice),
-	nomix.TagParserNotImpl,
+	nomix.TagParseFunc(ParseInt64Slice),
 )
 
 // Int64SliceSpec returns a [nomix.KindSpec] for [Int64Slice] type.
@@ -25,12 +25,20 @@ func Int64SliceSpec() nomix.KindSpec { return int64SliceSpec }
 
 // NewInt64Slice returns a new instance of [Int64Slice].
 func NewInt64Slice(name string, val ...int64) *Int64Slice {
+	return newInt64Slice(name, val, nomix.NewOptions())
+}
+
+// newInt64Slice returns a new instance of [Int64Slice] using
+// [nomix.Options.SliceEncoder] for the database representation.
+func newInt64Slice(name string, val []int64, def nomix.Options) *Int64Slice {
+	valuer, scanner := sliceSQL(def, false, strValueInt64, parseInt64Elem)
 	return nomix.NewSlice(
 		name,
 		val,
 		nomix.KindInt64Slice,
 		strValueInt64Slice,
-		nil,
+		valuer,
+		scanner,
 	)
 }
 
@@ -41,14 +49,42 @@ func NewInt64Slice(name string, val ...int64) *Int64Slice {
 func CreateInt64Slice(
 	name string,
 	val any,
-	_ ...nomix.Option,
+	opts ...nomix.Option,
 ) (*Int64Slice, error) {
 
+	def := nomix.NewOptions(opts...)
 	v, err := nomix.CreateInt64Slice(val)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", name, err)
 	}
-	return NewInt64Slice(name, v...), nil
+	return newInt64Slice(name, v, def), nil
+}
+
+// ParseInt64Slice parses a string representation of the int64 slice tag. Both
+// the JSON-array form, as returned by the String method, and the delimited
+// form are supported. Use [nomix.WithSeparator], [nomix.WithQuote] and
+// [nomix.WithNoTrim] options to configure the delimited form. The
+// [nomix.Options.Radix] applies only to the delimited form, the JSON-array
+// form is always parsed in base 10.
+func ParseInt64Slice(
+	name string,
+	val string,
+	opts ...nomix.Option,
+) (*Int64Slice, error) {
+
+	def := nomix.NewOptions(opts...)
+	radix := sliceRadix(val, def)
+	v, err := parseSlice(val, def, func(s string) (int64, error) {
+		v, err := strconv.ParseInt(s, radix, 64)
+		if err != nil {
+			return 0, nomix.ErrInvFormat
+		}
+		return v, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return newInt64Slice(name, v, def), nil
 }
 
 // strValueInt64Slice converts an int64 slice to its string representation.
@@ -62,3 +98,12 @@ func strValueInt64Slice(v []int64) string {
 	}
 	return ret + "]"
 }
+
+// parseInt64Elem parses base 10 string representation of int64.
+func parseInt64Elem(val string) (int64, error) {
+	v, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return 0, nomix.ErrInvFormat
+	}
+	return v, nil
+}
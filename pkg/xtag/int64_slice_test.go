@@ -24,8 +24,11 @@ func Test_Int64SliceSpec(t *testing.T) {
 	assert.Equal(t, nomix.KindInt64Slice, tag.TagKind())
 
 	tag, err = have.TagParse("name", "[42, 44]")
-	assert.ErrorIs(t, nomix.ErrNotImpl, err)
-	assert.Nil(t, tag)
+	assert.NoError(t, err)
+	assert.SameType(t, &Int64Slice{}, tag)
+	assert.Equal(t, "name", tag.TagName())
+	assert.Equal(t, []int64{42, 44}, tag.TagValue())
+	assert.Equal(t, nomix.KindInt64Slice, tag.TagKind())
 }
 
 func Test_NewInt64Slice(t *testing.T) {
@@ -110,3 +113,129 @@ func Test_strValueInt64Slice(t *testing.T) {
 	// --- Then ---
 	assert.Equal(t, "[42, 44]", have)
 }
+
+func Test_ParseInt64Slice(t *testing.T) {
+	t.Run("json form", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseInt64Slice("name", "[42, -44]")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.SameType(t, &Int64Slice{}, tag)
+		assert.Equal(t, "name", tag.TagName())
+		assert.Equal(t, []int64{42, -44}, tag.TagValue())
+		assert.Equal(t, nomix.KindInt64Slice, tag.TagKind())
+	})
+
+	t.Run("delimited form", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseInt64Slice("name", "42 ; 44", nomix.WithSeparator(";"))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{42, 44}, tag.TagValue())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		want := NewInt64Slice("name", []int64{1, 2, 3}...)
+
+		// --- When ---
+		have, err := ParseInt64Slice("name", want.String())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, want.TagValue(), have.TagValue())
+	})
+
+	t.Run("round trip with hex radix", func(t *testing.T) {
+		// --- Given ---
+		want := NewInt64Slice("name", []int64{10, -16}...)
+
+		// --- When ---
+		opt := nomix.WithRadixHEX
+		have, err := ParseInt64Slice("name", want.String(), opt)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, want.TagValue(), have.TagValue())
+	})
+
+	t.Run("error - invalid element", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseInt64Slice("name", "42,abc")
+
+		// --- Then ---
+		assert.ErrorEqual(t, "name: invalid element format", err)
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, tag)
+	})
+}
+
+func Test_Int64Slice_Value(t *testing.T) {
+	t.Run("default encoder", func(t *testing.T) {
+		// --- Given ---
+		tag := NewInt64Slice("name", 4, 2)
+
+		// --- When ---
+		have, err := tag.Value()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `[4,2]`, have)
+	})
+
+	t.Run("custom encoder", func(t *testing.T) {
+		// --- Given ---
+		opt := nomix.WithSliceEncoder(nomix.PGArray{})
+		tag, err := CreateInt64Slice("name", []int64{4, 2}, opt)
+		assert.NoError(t, err)
+
+		// --- When ---
+		have, err := tag.Value()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{4,2}`, have)
+	})
+}
+
+func Test_Int64Slice_Scan(t *testing.T) {
+	t.Run("default encoder", func(t *testing.T) {
+		// --- Given ---
+		tag := NewInt64Slice("name")
+
+		// --- When ---
+		err := tag.Scan([]byte(`[4,2]`))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{4, 2}, tag.Get())
+	})
+
+	t.Run("custom encoder", func(t *testing.T) {
+		// --- Given ---
+		opt := nomix.WithSliceEncoder(nomix.PGArray{})
+		tag, err := CreateInt64Slice("name", []int64{4, 2}[:0], opt)
+		assert.NoError(t, err)
+
+		// --- When ---
+		err = tag.Scan(`{4,2}`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{4, 2}, tag.Get())
+	})
+
+	t.Run("error - invalid format", func(t *testing.T) {
+		// --- Given ---
+		tag := NewInt64Slice("name")
+
+		// --- When ---
+		err := tag.Scan(`[4, abc]`)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.ErrorContain(t, "name: ", err)
+	})
+}
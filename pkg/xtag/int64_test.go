@@ -139,3 +139,50 @@ func Test_strValueInt64(t *testing.T) {
 	// --- Then ---
 	assert.Equal(t, "42", have)
 }
+
+func Test_sqlScanInt64(t *testing.T) {
+	t.Run("int64", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanInt64(int64(42))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), have)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanInt64("-42")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-42), have)
+	})
+
+	t.Run("byte slice", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanInt64([]byte("42"))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), have)
+	})
+
+	t.Run("error - invalid format", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanInt64("abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Equal(t, int64(0), have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanInt64(42.0)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Equal(t, int64(0), have)
+	})
+}
@@ -17,7 +17,7 @@ type IntSlice = nomix.Slice[int]
 var intSliceSpec = nomix.NewKindSpec(
 	nomix.KindIntSlice,
 	nomix.TagCreateFunc(CreateIntSlice),
-	nomix.TagParserNotImpl,
+	nomix.TagParseFunc(ParseIntSlice),
 )
 
 // IntSliceSpec returns a [nomix.KindSpec] for [IntSlice] type.
@@ -25,18 +25,38 @@ func IntSliceSpec() nomix.KindSpec { return intSliceSpec }
 
 // NewIntSlice returns a new instance of [IntSlice].
 func NewIntSlice(name string, val ...int) *IntSlice {
-	return nomix.NewSlice(name, val, nomix.KindIntSlice, strValueIntSlice, nil)
+	return newIntSlice(name, val, nomix.NewOptions())
+}
+
+// newIntSlice returns a new instance of [IntSlice] using
+// [nomix.Options.SliceEncoder] for the database representation.
+func newIntSlice(name string, val []int, def nomix.Options) *IntSlice {
+	valuer, scanner := sliceSQL(def, false, strconv.Itoa, parseIntElem)
+	return nomix.NewSlice(
+		name,
+		val,
+		nomix.KindIntSlice,
+		strValueIntSlice,
+		valuer,
+		scanner,
+	)
 }
 
 // CreateIntSlice casts the value to []int. Returns the [IntSlice] instance
 // with the given name and nil error on success. Returns nil and [ErrInvType]
 // if the value is not []int type.
-func CreateIntSlice(name string, val any, _ ...nomix.Option) (*IntSlice, error) {
-	v, err := createIntSlice(val, nomix.Options{})
+func CreateIntSlice(
+	name string,
+	val any,
+	opts ...nomix.Option,
+) (*IntSlice, error) {
+
+	def := nomix.NewOptions(opts...)
+	v, err := createIntSlice(val, def)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", name, err)
 	}
-	return NewIntSlice(name, v...), nil
+	return newIntSlice(name, v, def), nil
 }
 
 // createIntSlice casts the value to []int. Returns the []int and nil error on
@@ -48,6 +68,28 @@ func createIntSlice(val any, _ nomix.Options) ([]int, error) {
 	return nil, nomix.ErrInvType
 }
 
+// ParseIntSlice parses a string representation of the int slice tag. Both the
+// JSON-array form, as returned by the String method, and the delimited form
+// are supported. Use [nomix.WithSeparator], [nomix.WithQuote] and
+// [nomix.WithNoTrim] options to configure the delimited form. The
+// [nomix.Options.Radix] applies only to the delimited form, the JSON-array
+// form is always parsed in base 10.
+func ParseIntSlice(name, val string, opts ...nomix.Option) (*IntSlice, error) {
+	def := nomix.NewOptions(opts...)
+	radix := sliceRadix(val, def)
+	v, err := parseSlice(val, def, func(s string) (int, error) {
+		v, err := strconv.ParseInt(s, radix, 0)
+		if err != nil {
+			return 0, nomix.ErrInvFormat
+		}
+		return int(v), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return newIntSlice(name, v, def), nil
+}
+
 // strValueIntSlice converts an int slice to its string representation.
 func strValueIntSlice(v []int) string {
 	ret := "["
@@ -59,3 +101,12 @@ func strValueIntSlice(v []int) string {
 	}
 	return ret + "]"
 }
+
+// parseIntElem parses base 10 string representation of int.
+func parseIntElem(val string) (int, error) {
+	v, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, nomix.ErrInvFormat
+	}
+	return v, nil
+}
@@ -24,8 +24,11 @@ func Test_IntSliceSpec(t *testing.T) {
 	assert.Equal(t, nomix.KindIntSlice, tag.TagKind())
 
 	tag, err = have.TagParse("name", "[42, 44]")
-	assert.ErrorIs(t, nomix.ErrNotImpl, err)
-	assert.Nil(t, tag)
+	assert.NoError(t, err)
+	assert.SameType(t, &IntSlice{}, tag)
+	assert.Equal(t, "name", tag.TagName())
+	assert.Equal(t, []int{42, 44}, tag.TagValue())
+	assert.Equal(t, nomix.KindIntSlice, tag.TagKind())
 }
 
 func Test_NewIntSlice(t *testing.T) {
@@ -65,6 +68,66 @@ func Test_CreateIntSlice(t *testing.T) {
 	})
 }
 
+func Test_ParseIntSlice(t *testing.T) {
+	t.Run("json form", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseIntSlice("name", "[42, -44]")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.SameType(t, &IntSlice{}, tag)
+		assert.Equal(t, "name", tag.TagName())
+		assert.Equal(t, []int{42, -44}, tag.TagValue())
+		assert.Equal(t, nomix.KindIntSlice, tag.TagKind())
+	})
+
+	t.Run("delimited form", func(t *testing.T) {
+		// --- Given ---
+		opts := []nomix.Option{nomix.WithSeparator("|"), nomix.WithRadixHEX}
+
+		// --- When ---
+		tag, err := ParseIntSlice("name", "AA|-AA", opts...)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []int{170, -170}, tag.TagValue())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		want := NewIntSlice("name", []int{1, 2, 3}...)
+
+		// --- When ---
+		have, err := ParseIntSlice("name", want.String())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, want.TagValue(), have.TagValue())
+	})
+
+	t.Run("round trip with hex radix", func(t *testing.T) {
+		// --- Given ---
+		want := NewIntSlice("name", []int{10, -16}...)
+
+		// --- When ---
+		have, err := ParseIntSlice("name", want.String(), nomix.WithRadixHEX)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, want.TagValue(), have.TagValue())
+	})
+
+	t.Run("error - invalid element", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseIntSlice("name", "[42, abc]")
+
+		// --- Then ---
+		assert.ErrorEqual(t, "name: invalid element format", err)
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, tag)
+	})
+}
+
 func Test_createIntSlice(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// --- When ---
@@ -93,3 +156,71 @@ func Test_createIntSlice(t *testing.T) {
 		assert.Nil(t, have)
 	})
 }
+
+func Test_IntSlice_Value(t *testing.T) {
+	t.Run("default encoder", func(t *testing.T) {
+		// --- Given ---
+		tag := NewIntSlice("name", 4, 2)
+
+		// --- When ---
+		have, err := tag.Value()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `[4,2]`, have)
+	})
+
+	t.Run("custom encoder", func(t *testing.T) {
+		// --- Given ---
+		opt := nomix.WithSliceEncoder(nomix.PGArray{})
+		tag, err := CreateIntSlice("name", []int{4, 2}, opt)
+		assert.NoError(t, err)
+
+		// --- When ---
+		have, err := tag.Value()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{4,2}`, have)
+	})
+}
+
+func Test_IntSlice_Scan(t *testing.T) {
+	t.Run("default encoder", func(t *testing.T) {
+		// --- Given ---
+		tag := NewIntSlice("name")
+
+		// --- When ---
+		err := tag.Scan([]byte(`[4,2]`))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []int{4, 2}, tag.Get())
+	})
+
+	t.Run("custom encoder", func(t *testing.T) {
+		// --- Given ---
+		opt := nomix.WithSliceEncoder(nomix.PGArray{})
+		tag, err := CreateIntSlice("name", []int{4, 2}[:0], opt)
+		assert.NoError(t, err)
+
+		// --- When ---
+		err = tag.Scan(`{4,2}`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []int{4, 2}, tag.Get())
+	})
+
+	t.Run("error - invalid format", func(t *testing.T) {
+		// --- Given ---
+		tag := NewIntSlice("name")
+
+		// --- When ---
+		err := tag.Scan(`4,2`)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.ErrorContain(t, "name: ", err)
+	})
+}
@@ -138,3 +138,32 @@ func Test_sqlValueInt(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, int64(42), have)
 }
+
+func Test_sqlScanInt(t *testing.T) {
+	t.Run("int64", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanInt(int64(42))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 42, have)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanInt("42")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 42, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanInt(nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Equal(t, 0, have)
+	})
+}
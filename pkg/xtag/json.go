@@ -4,6 +4,7 @@
 package xtag
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
@@ -25,7 +26,14 @@ func JSONSpec() nomix.KindSpec { return jsonSpec }
 
 // NewJSON returns a new instance of [JSON].
 func NewJSON(name string, v json.RawMessage) *JSON {
-	return nomix.NewSlice(name, v, nomix.KindJSON, strValueJSON, nil)
+	return nomix.NewSlice(
+		name,
+		v,
+		nomix.KindJSON,
+		strValueJSON,
+		nil,
+		sqlScanJSON,
+	)
 }
 
 // CreateJSON casts the value to [json.RawMessage]. Returns the [JSON] with the
@@ -72,3 +80,23 @@ func ParseJSON(name, v string, _ ...nomix.Option) (*JSON, error) {
 	}
 	return NewJSON(name, json.RawMessage(v)), nil
 }
+
+// sqlScanJSON converts the database value to [json.RawMessage]. The []byte
+// values are copied, since the database driver may reuse the buffer. Returns
+// [nomix.ErrInvType] if the value's type is not []byte or string, and
+// [nomix.ErrInvFormat] if the value is not a valid JSON.
+func sqlScanJSON(src any) ([]byte, error) {
+	var v []byte
+	switch val := src.(type) {
+	case []byte:
+		v = bytes.Clone(val)
+	case string:
+		v = []byte(val)
+	default:
+		return nil, nomix.ErrInvType
+	}
+	if !json.Valid(v) {
+		return nil, nomix.ErrInvFormat
+	}
+	return v, nil
+}
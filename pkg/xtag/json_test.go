@@ -168,3 +168,46 @@ func Test_ParseJSON(t *testing.T) {
 		assert.Nil(t, tag)
 	})
 }
+
+func Test_sqlScanJSON(t *testing.T) {
+	t.Run("byte slice is copied", func(t *testing.T) {
+		// --- Given ---
+		src := []byte(`{"A":1}`)
+
+		// --- When ---
+		have, err := sqlScanJSON(src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(`{"A":1}`), have)
+		src[0] = '['
+		assert.Equal(t, []byte(`{"A":1}`), have)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanJSON(`[1, 2]`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(`[1, 2]`), have)
+	})
+
+	t.Run("error - invalid format", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanJSON(`{"A":`)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanJSON(int64(42))
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Nil(t, have)
+	})
+}
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xtag
+
+import (
+	"database/sql/driver"
+	"strings"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+// parseSlice parses a string representation of a slice. Two forms are
+// supported:
+//
+//   - The JSON-array form, when the trimmed value starts with "[" and ends
+//     with "]", for example: `[1, 2]` or `["a", "b"]`. Elements are separated
+//     by commas, white space around elements is ignored, and quoted elements
+//     are unquoted using JSON string rules. This is the form returned by the
+//     String method of all slice tags.
+//   - The delimited form, for example: `1,2` or `a;"b;c"`. Elements are split
+//     using [nomix.Options.Separator], may be quoted with
+//     [nomix.Options.Quote] and are trimmed when [nomix.Options.Trim] is set.
+//
+// Each element is converted with the elem function. Returns an empty slice
+// for an empty string or an empty JSON array. Returns nil and
+// [nomix.ErrInvFormat] if the value is malformed.
+func parseSlice[T any](
+	val string,
+	def nomix.Options,
+	elem func(string) (T, error),
+) ([]T, error) {
+
+	var parts []string
+	var err error
+
+	if isJSONArray(val) {
+		parts, err = nomix.JSONArray{}.SliceDecode(strings.TrimSpace(val))
+	} else {
+		enc := nomix.Delimited{
+			Separator: def.Separator,
+			Quote:     def.Quote,
+			Trim:      def.Trim,
+		}
+		parts, err = enc.SliceDecode(val)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]T, len(parts))
+	for i, part := range parts {
+		if ret[i], err = elem(part); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// isJSONArray returns true if the trimmed value starts with "[" and ends with
+// "]".
+func isJSONArray(val string) bool {
+	trimmed := strings.TrimSpace(val)
+	return strings.HasPrefix(trimmed, "[") && strings.HasSuffix(trimmed, "]")
+}
+
+// sliceRadix returns the base for parsing integer elements of the slice. The
+// String methods write the JSON-array form in decimal, so it is always parsed
+// in base 10, while the delimited form uses the [nomix.Options.Radix].
+func sliceRadix(val string, def nomix.Options) int {
+	if isJSONArray(val) {
+		return 10
+	}
+	return def.Radix
+}
+
+// sliceSQL returns the functions converting slices to and from their
+// database representation using [nomix.Options.SliceEncoder]. The str
+// function returns the element's string representation, and the elem
+// function converts it back. The quote flag is passed to the encoder and
+// must be set for textual elements.
+//
+// The returned scanner supports the NULL value, which results in a nil
+// slice, the []T values which are returned as-is, and []byte or string
+// values which are decoded by the encoder.
+func sliceSQL[T any](
+	def nomix.Options,
+	quote bool,
+	str func(T) string,
+	elem func(string) (T, error),
+) (func([]T) (driver.Value, error), func(any) ([]T, error)) {
+
+	enc := def.SliceEncoder
+	if enc == nil {
+		enc = nomix.JSONArray{}
+	}
+
+	valuer := func(v []T) (driver.Value, error) {
+		elems := make([]string, len(v))
+		for i, val := range v {
+			elems[i] = str(val)
+		}
+		val, err := enc.SliceEncode(elems, quote)
+		if err != nil {
+			return nil, err
+		}
+		return val, nil
+	}
+
+	scanner := func(src any) ([]T, error) {
+		var txt string
+		switch v := src.(type) {
+		case nil:
+			return nil, nil
+		case []T:
+			return v, nil
+		case []byte:
+			txt = string(v)
+		case string:
+			txt = v
+		default:
+			return nil, nomix.ErrInvType
+		}
+		parts, err := enc.SliceDecode(txt)
+		if err != nil {
+			return nil, err
+		}
+		ret := make([]T, len(parts))
+		for i, part := range parts {
+			if ret[i], err = elem(part); err != nil {
+				return nil, err
+			}
+		}
+		return ret, nil
+	}
+
+	return valuer, scanner
+}
@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xtag
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+func Test_parseSlice_success_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		str  string
+		opts []nomix.Option
+		exp  []string
+	}{
+		{"empty", "", nil, []string{}},
+		{"empty json", "[]", nil, []string{}},
+		{"empty json with spaces", " [ ] ", nil, []string{}},
+		{"json", `["a", "b"]`, nil, []string{"a", "b"}},
+		{"json not quoted", `[a, b]`, nil, []string{"a", "b"}},
+		{"json with comma", `["a,b", "c"]`, nil, []string{"a,b", "c"}},
+		{"json with escapes", `["a\"b", "A"]`, nil, []string{`a"b`, "A"}},
+		{"json empty string", `[""]`, nil, []string{""}},
+		{"delimited", "a,b", nil, []string{"a", "b"}},
+		{"delimited single", "a", nil, []string{"a"}},
+		{"delimited trimmed", " a , b ", nil, []string{"a", "b"}},
+		{
+			"delimited not trimmed",
+			" a , b ",
+			[]nomix.Option{nomix.WithNoTrim},
+			[]string{" a ", " b "},
+		},
+		{"delimited empty element", "a,,b", nil, []string{"a", "", "b"}},
+		{"delimited trailing separator", "a,", nil, []string{"a", ""}},
+		{"delimited quoted", `"a,b",c`, nil, []string{"a,b", "c"}},
+		{"delimited quoted trimmed", ` "a" , c`, nil, []string{"a", "c"}},
+		{"delimited doubled quote", `"a""b",c`, nil, []string{`a"b`, "c"}},
+		{"delimited quoted last", `a,"b,c"`, nil, []string{"a", "b,c"}},
+		{
+			"delimited custom separator",
+			"a; b",
+			[]nomix.Option{nomix.WithSeparator(";")},
+			[]string{"a", "b"},
+		},
+		{
+			"delimited multi char separator",
+			"a::b",
+			[]nomix.Option{nomix.WithSeparator("::")},
+			[]string{"a", "b"},
+		},
+		{
+			"delimited empty separator",
+			"a,b",
+			[]nomix.Option{nomix.WithSeparator("")},
+			[]string{"a", "b"},
+		},
+		{
+			"delimited custom quote",
+			"'a,b',c",
+			[]nomix.Option{nomix.WithQuote('\'')},
+			[]string{"a,b", "c"},
+		},
+		{
+			"delimited quoting disabled",
+			`"a,b"`,
+			[]nomix.Option{nomix.WithQuote(0)},
+			[]string{`"a`, `b"`},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			def := nomix.NewOptions(tc.opts...)
+
+			// --- When ---
+			have, err := parseSlice(tc.str, def, parseStringElem)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_parseSlice_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		str string
+	}{
+		{"json not closed quote", `["a]`},
+		{"json empty element", `["a",,"b"]`},
+		{"json trailing comma", `["a",]`},
+		{"json quote inside element", `[a"b]`},
+		{"json invalid escape", `["\x"]`},
+		{"delimited not closed quote", `"a,b`},
+		{"delimited text after quote", `"a"b,c`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			def := nomix.NewOptions()
+
+			// --- When ---
+			have, err := parseSlice(tc.str, def, parseStringElem)
+
+			// --- Then ---
+			assert.ErrorIs(t, nomix.ErrInvFormat, err)
+			assert.Nil(t, have)
+		})
+	}
+}
+
+func Test_parseSlice(t *testing.T) {
+	t.Run("error - element", func(t *testing.T) {
+		// --- Given ---
+		def := nomix.NewOptions()
+
+		// --- When ---
+		have, err := parseSlice("true,abc", def, parseBoolElem)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_sliceSQL(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		// --- Given ---
+		def := nomix.NewOptions()
+		valuer, _ := sliceSQL(def, true, strValueString, parseStringElem)
+
+		// --- When ---
+		have, err := valuer([]string{"a", "b"})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `["a","b"]`, have)
+	})
+
+	t.Run("value with nil encoder", func(t *testing.T) {
+		// --- Given ---
+		def := nomix.Options{}
+		valuer, _ := sliceSQL(def, false, strValueString, parseStringElem)
+
+		// --- When ---
+		have, err := valuer([]string{"1", "2"})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `[1,2]`, have)
+	})
+
+	t.Run("value error", func(t *testing.T) {
+		// --- Given ---
+		enc := nomix.Delimited{Separator: ","}
+		def := nomix.NewOptions(nomix.WithSliceEncoder(enc))
+		valuer, _ := sliceSQL(def, true, strValueString, parseStringElem)
+
+		// --- When ---
+		have, err := valuer([]string{"a,b"})
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvValue, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("scan nil", func(t *testing.T) {
+		// --- Given ---
+		def := nomix.NewOptions()
+		_, scanner := sliceSQL(def, true, strValueString, parseStringElem)
+
+		// --- When ---
+		have, err := scanner(nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("scan slice", func(t *testing.T) {
+		// --- Given ---
+		def := nomix.NewOptions()
+		_, scanner := sliceSQL(def, true, strValueString, parseStringElem)
+		src := []string{"a", "b"}
+
+		// --- When ---
+		have, err := scanner(src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Same(t, src, have)
+	})
+
+	t.Run("scan string", func(t *testing.T) {
+		// --- Given ---
+		def := nomix.NewOptions(nomix.WithSliceEncoder(nomix.PGArray{}))
+		_, scanner := sliceSQL(def, true, strValueString, parseStringElem)
+
+		// --- When ---
+		have, err := scanner(`{a,"b,c"}`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b,c"}, have)
+	})
+
+	t.Run("scan byte slice", func(t *testing.T) {
+		// --- Given ---
+		def := nomix.NewOptions()
+		_, scanner := sliceSQL(def, true, strValueString, parseStringElem)
+
+		// --- When ---
+		have, err := scanner([]byte(`["a", "b"]`))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, have)
+	})
+
+	t.Run("error - scan invalid type", func(t *testing.T) {
+		// --- Given ---
+		def := nomix.NewOptions()
+		_, scanner := sliceSQL(def, true, strValueString, parseStringElem)
+
+		// --- When ---
+		have, err := scanner(int64(42))
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - scan invalid format", func(t *testing.T) {
+		// --- Given ---
+		def := nomix.NewOptions()
+		_, scanner := sliceSQL(def, true, strValueString, parseStringElem)
+
+		// --- When ---
+		have, err := scanner("a,b")
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - scan invalid element", func(t *testing.T) {
+		// --- Given ---
+		def := nomix.NewOptions()
+		_, scanner := sliceSQL(def, false, strconv.Itoa, parseIntElem)
+
+		// --- When ---
+		have, err := scanner("[1, a]")
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+}
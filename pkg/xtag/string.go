@@ -26,7 +26,14 @@ func StringSpec() nomix.KindSpec { return stringSpec }
 
 // NewString returns a new instance of [String].
 func NewString(name, val string) *String {
-	return nomix.NewSingle(name, val, nomix.KindString, strValueString, nil)
+	return nomix.NewSingle(
+		name,
+		val,
+		nomix.KindString,
+		strValueString,
+		nil,
+		sqlScanString,
+	)
 }
 
 // CreateString casts the value to a string. Returns the [String] instance with
@@ -41,3 +48,15 @@ func CreateString(name string, val any, _ ...nomix.Option) (*String, error) {
 
 // strValueString returns the string as is.
 func strValueString(v string) string { return v }
+
+// sqlScanString converts the database value to string. Returns
+// [nomix.ErrInvType] if the value's type is not string or []byte.
+func sqlScanString(src any) (string, error) {
+	switch v := src.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	}
+	return "", nomix.ErrInvType
+}
@@ -16,7 +16,7 @@ type StringSlice = nomix.Slice[string]
 var stringSliceSpec = nomix.NewKindSpec(
 	nomix.KindStringSlice,
 	nomix.TagCreateFunc(CreateStringSlice),
-	nomix.TagParserNotImpl,
+	nomix.TagParseFunc(ParseStringSlice),
 )
 
 // StringSliceSpec returns a [nomix.KindSpec] for [StringSlice] type.
@@ -24,18 +24,38 @@ func StringSliceSpec() nomix.KindSpec { return stringSliceSpec }
 
 // NewStringSlice returns a new instance of [StringSlice].
 func NewStringSlice(name string, val ...string) *StringSlice {
-	return nomix.NewSlice(name, val, nomix.KindStringSlice, strValueStringSlice, nil)
+	return newStringSlice(name, val, nomix.NewOptions())
+}
+
+// newStringSlice returns a new instance of [StringSlice] using
+// [nomix.Options.SliceEncoder] for the database representation.
+func newStringSlice(name string, val []string, def nomix.Options) *StringSlice {
+	valuer, scanner := sliceSQL(def, true, strValueString, parseStringElem)
+	return nomix.NewSlice(
+		name,
+		val,
+		nomix.KindStringSlice,
+		strValueStringSlice,
+		valuer,
+		scanner,
+	)
 }
 
 // CreateStringSlice casts the value to []string. Returns the [StringSlice]
 // instance with the given name and nil error on success. Returns nil and
 // [ErrInvType] if the value is not the []string type.
-func CreateStringSlice(name string, val any, _ ...nomix.Option) (*StringSlice, error) {
-	v, err := createStringSlice(val, nomix.Options{})
+func CreateStringSlice(
+	name string,
+	val any,
+	opts ...nomix.Option,
+) (*StringSlice, error) {
+
+	def := nomix.NewOptions(opts...)
+	v, err := createStringSlice(val, def)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", name, err)
 	}
-	return NewStringSlice(name, v...), nil
+	return newStringSlice(name, v, def), nil
 }
 
 // createStringSlice casts the value to []string. Returns the []string and nil
@@ -48,6 +68,28 @@ func createStringSlice(val any, _ nomix.Options) ([]string, error) {
 	return nil, nomix.ErrInvType
 }
 
+// ParseStringSlice parses a string representation of the string slice tag.
+// Both the JSON-array form, as returned by the String method, and the
+// delimited form are supported. Use [nomix.WithSeparator],
+// [nomix.WithQuote] and [nomix.WithNoTrim] options to configure the
+// delimited form.
+func ParseStringSlice(
+	name string,
+	val string,
+	opts ...nomix.Option,
+) (*StringSlice, error) {
+
+	def := nomix.NewOptions(opts...)
+	v, err := parseSlice(val, def, parseStringElem)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return newStringSlice(name, v, def), nil
+}
+
+// parseStringElem returns the string as is. Never returns an error.
+func parseStringElem(val string) (string, error) { return val, nil }
+
 // strValueStringSlice converts a string slice to its string representation.
 func strValueStringSlice(v []string) string {
 	ret := "["
@@ -55,7 +97,7 @@ func strValueStringSlice(v []string) string {
 		if i > 0 {
 			ret += ", "
 		}
-		ret += `"` + val + `"`
+		ret += nomix.QuoteJSON(val)
 	}
 	return ret + "]"
 }
@@ -24,8 +24,11 @@ func Test_StringSliceSpec(t *testing.T) {
 	assert.Equal(t, nomix.KindStringSlice, tag.TagKind())
 
 	tag, err = have.TagParse("name", `["abc", "xyz"]`)
-	assert.ErrorIs(t, nomix.ErrNotImpl, err)
-	assert.Nil(t, tag)
+	assert.NoError(t, err)
+	assert.SameType(t, &StringSlice{}, tag)
+	assert.Equal(t, "name", tag.TagName())
+	assert.Equal(t, []string{"abc", "xyz"}, tag.TagValue())
+	assert.Equal(t, nomix.KindStringSlice, tag.TagKind())
 }
 
 func Test_NewStringSlice(t *testing.T) {
@@ -65,6 +68,54 @@ func Test_CreateStringSlice(t *testing.T) {
 	})
 }
 
+func Test_ParseStringSlice(t *testing.T) {
+	t.Run("json form", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseStringSlice("name", `["abc", "x,\"y"]`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.SameType(t, &StringSlice{}, tag)
+		assert.Equal(t, "name", tag.TagName())
+		assert.Equal(t, []string{"abc", `x,"y`}, tag.TagValue())
+		assert.Equal(t, nomix.KindStringSlice, tag.TagKind())
+	})
+
+	t.Run("delimited form", func(t *testing.T) {
+		// --- Given ---
+		opt := nomix.WithSeparator(";")
+
+		// --- When ---
+		tag, err := ParseStringSlice("name", `abc;"x;y"`, opt)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"abc", "x;y"}, tag.TagValue())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		want := NewStringSlice("name", []string{"a", `b"c`, "d,e"}...)
+
+		// --- When ---
+		have, err := ParseStringSlice("name", want.String())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, want.TagValue(), have.TagValue())
+	})
+
+	t.Run("error - invalid format", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseStringSlice("name", `["abc]`)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "name: invalid element format", err)
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, tag)
+	})
+}
+
 func Test_createStringSlice(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// --- When ---
@@ -93,3 +144,71 @@ func Test_createStringSlice(t *testing.T) {
 		assert.Nil(t, have)
 	})
 }
+
+func Test_StringSlice_Value(t *testing.T) {
+	t.Run("default encoder", func(t *testing.T) {
+		// --- Given ---
+		tag := NewStringSlice("name", "a", `b"c`)
+
+		// --- When ---
+		have, err := tag.Value()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `["a","b\"c"]`, have)
+	})
+
+	t.Run("custom encoder", func(t *testing.T) {
+		// --- Given ---
+		opt := nomix.WithSliceEncoder(nomix.PGArray{})
+		tag, err := CreateStringSlice("name", []string{"a", `b"c`}, opt)
+		assert.NoError(t, err)
+
+		// --- When ---
+		have, err := tag.Value()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{"a","b\"c"}`, have)
+	})
+}
+
+func Test_StringSlice_Scan(t *testing.T) {
+	t.Run("default encoder", func(t *testing.T) {
+		// --- Given ---
+		tag := NewStringSlice("name")
+
+		// --- When ---
+		err := tag.Scan([]byte(`["a","b\"c"]`))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", `b"c`}, tag.Get())
+	})
+
+	t.Run("custom encoder", func(t *testing.T) {
+		// --- Given ---
+		opt := nomix.WithSliceEncoder(nomix.PGArray{})
+		tag, err := CreateStringSlice("name", []string{"a", `b"c`}[:0], opt)
+		assert.NoError(t, err)
+
+		// --- When ---
+		err = tag.Scan(`{"a","b\"c"}`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", `b"c`}, tag.Get())
+	})
+
+	t.Run("error - invalid format", func(t *testing.T) {
+		// --- Given ---
+		tag := NewStringSlice("name")
+
+		// --- When ---
+		err := tag.Scan(`["a]`)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.ErrorContain(t, "name: ", err)
+	})
+}
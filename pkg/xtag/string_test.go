@@ -88,3 +88,32 @@ func Test_strValueString(t *testing.T) {
 	// --- Then ---
 	assert.Equal(t, "abc", have)
 }
+
+func Test_sqlScanString(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanString("abc")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", have)
+	})
+
+	t.Run("byte slice", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanString([]byte("abc"))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanString(int64(42))
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Equal(t, "", have)
+	})
+}
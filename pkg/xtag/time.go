@@ -32,6 +32,7 @@ func NewTime(name string, val time.Time) *Time {
 		nomix.KindTime,
 		strValueTime,
 		sqlValueTime,
+		sqlScanTime,
 	)
 }
 
@@ -68,3 +69,18 @@ func strValueTime(v time.Time) string { return v.Format(time.RFC3339Nano) }
 
 // sqlValueTime returns the value as is. Never returns an error.
 func sqlValueTime(v time.Time) (driver.Value, error) { return v, nil }
+
+// sqlScanTime converts the database value to [time.Time]. The []byte and
+// string values are parsed with [nomix.ParseTime] using the default options.
+// Returns [nomix.ErrInvType] if the value's type is not supported.
+func sqlScanTime(src any) (time.Time, error) {
+	switch v := src.(type) {
+	case time.Time:
+		return v, nil
+	case []byte:
+		return nomix.ParseTime(string(v), nomix.NewOptions())
+	case string:
+		return nomix.ParseTime(v, nomix.NewOptions())
+	}
+	return time.Time{}, nomix.ErrInvType
+}
@@ -17,7 +17,7 @@ type TimeSlice = nomix.Slice[time.Time]
 var timeSliceSpec = nomix.NewKindSpec(
 	nomix.KindTimeSlice,
 	nomix.TagCreateFunc(CreateTimeSlice),
-	nomix.TagParserNotImpl,
+	nomix.TagParseFunc(ParseTimeSlice),
 )
 
 // TimeSliceSpec returns a [nomix.KindSpec] for [TimeSlice] type.
@@ -25,12 +25,34 @@ func TimeSliceSpec() nomix.KindSpec { return timeSliceSpec }
 
 // NewTimeSlice returns a new instance of [TimeSlice].
 func NewTimeSlice(name string, val ...time.Time) *TimeSlice {
+	return newTimeSlice(name, val, nomix.NewOptions())
+}
+
+// newTimeSlice returns a new instance of [TimeSlice] using
+// [nomix.Options.SliceEncoder] for the database representation. Elements are
+// formatted and parsed with [nomix.Options.TimeFormat] in
+// [nomix.Options.Location] when set.
+func newTimeSlice(
+	name string,
+	val []time.Time,
+	def nomix.Options,
+) *TimeSlice {
+
+	str := func(v time.Time) string {
+		if def.Location != nil {
+			v = v.In(def.Location)
+		}
+		return v.Format(def.TimeFormat)
+	}
+	elem := func(s string) (time.Time, error) { return nomix.ParseTime(s, def) }
+	valuer, scanner := sliceSQL(def, true, str, elem)
 	return nomix.NewSlice(
 		name,
 		val,
 		nomix.KindTimeSlice,
 		strValueTimeSlice,
-		nil,
+		valuer,
+		scanner,
 	)
 }
 
@@ -50,7 +72,28 @@ func CreateTimeSlice(
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", name, err)
 	}
-	return NewTimeSlice(name, v...), nil
+	return newTimeSlice(name, v, def), nil
+}
+
+// ParseTimeSlice parses a string representation of the [time.Time] slice tag.
+// Both the JSON-array form, as returned by the String method, and the
+// delimited form are supported. Use [nomix.WithSeparator],
+// [nomix.WithQuote] and [nomix.WithNoTrim] options to configure the
+// delimited form. The elements are parsed the same way [ParseTime] does.
+func ParseTimeSlice(
+	name string,
+	val string,
+	opts ...nomix.Option,
+) (*TimeSlice, error) {
+
+	def := nomix.NewOptions(opts...)
+	v, err := parseSlice(val, def, func(s string) (time.Time, error) {
+		return nomix.ParseTime(s, def)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return newTimeSlice(name, v, def), nil
 }
 
 // strValueTimeSlice converts a [time.Time] slice to its string representation.
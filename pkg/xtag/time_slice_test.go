@@ -28,8 +28,11 @@ func Test_TimeSliceSpec(t *testing.T) {
 
 	data := `["2000-01-02T03:04:05Z", "2001-01-02T03:04:05Z"]`
 	tag, err = have.TagParse("name", data)
-	assert.ErrorIs(t, nomix.ErrNotImpl, err)
-	assert.Nil(t, tag)
+	assert.NoError(t, err)
+	assert.SameType(t, &TimeSlice{}, tag)
+	assert.Equal(t, "name", tag.TagName())
+	assert.Equal(t, []time.Time{tim0, tim1}, tag.TagValue())
+	assert.Equal(t, nomix.KindTimeSlice, tag.TagKind())
 }
 
 func Test_NewTimeSlice(t *testing.T) {
@@ -79,3 +82,175 @@ func Test_CreateTimeSlice(t *testing.T) {
 		assert.Nil(t, tag)
 	})
 }
+
+func Test_ParseTimeSlice(t *testing.T) {
+	t.Run("json form", func(t *testing.T) {
+		// --- Given ---
+		data := `["2000-01-02T03:04:05Z", "2001-01-02T03:04:05Z"]`
+
+		// --- When ---
+		tag, err := ParseTimeSlice("name", data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.SameType(t, &TimeSlice{}, tag)
+		assert.Equal(t, "name", tag.TagName())
+		tim0 := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		tim1 := time.Date(2001, 1, 2, 3, 4, 5, 0, time.UTC)
+		assert.Equal(t, []time.Time{tim0, tim1}, tag.TagValue())
+		assert.Equal(t, nomix.KindTimeSlice, tag.TagKind())
+	})
+
+	t.Run("delimited form with options", func(t *testing.T) {
+		// --- Given ---
+		opts := []nomix.Option{
+			nomix.WithSeparator(";"),
+			nomix.WithTimeFormat(time.DateOnly),
+			nomix.WithZeroTime("0000-00-00"),
+		}
+
+		// --- When ---
+		tag, err := ParseTimeSlice("name", "2000-01-02; 0000-00-00", opts...)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		tim0 := time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, []time.Time{tim0, {}}, tag.TagValue())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		tim0 := time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC)
+		tim1 := time.Date(2001, 1, 2, 3, 4, 5, 0, time.UTC)
+		want := NewTimeSlice("name", tim0, tim1)
+
+		// --- When ---
+		have, err := ParseTimeSlice("name", want.String())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, want.TagValue(), have.TagValue())
+	})
+
+	t.Run("error - invalid element", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseTimeSlice("name", `["2000-01-02T03:04:05Z", "abc"]`)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "name: invalid element format", err)
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, tag)
+	})
+}
+
+func Test_TimeSlice_Value(t *testing.T) {
+	t.Run("default encoder", func(t *testing.T) {
+		// --- Given ---
+		tim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		tag := NewTimeSlice("name", tim)
+
+		// --- When ---
+		have, err := tag.Value()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `["2000-01-02T03:04:05Z"]`, have)
+	})
+
+	t.Run("custom encoder", func(t *testing.T) {
+		// --- Given ---
+		tim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		opt := nomix.WithSliceEncoder(nomix.NewDelimited())
+		tag, err := CreateTimeSlice("name", []time.Time{tim, tim}, opt)
+		assert.NoError(t, err)
+
+		// --- When ---
+		have, err := tag.Value()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "2000-01-02T03:04:05Z,2000-01-02T03:04:05Z", have)
+	})
+
+	t.Run("custom time format", func(t *testing.T) {
+		// --- Given ---
+		tim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		opt := nomix.WithTimeFormat(time.DateOnly)
+		tag, err := CreateTimeSlice("name", []time.Time{tim}, opt)
+		assert.NoError(t, err)
+
+		// --- When ---
+		have, err := tag.Value()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `["2000-01-02"]`, have)
+	})
+}
+
+func Test_TimeSlice_Scan(t *testing.T) {
+	t.Run("default encoder", func(t *testing.T) {
+		// --- Given ---
+		tag := NewTimeSlice("name")
+
+		// --- When ---
+		err := tag.Scan(`["2000-01-02T03:04:05Z"]`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		tim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		assert.Equal(t, []time.Time{tim}, tag.Get())
+	})
+
+	t.Run("options are used", func(t *testing.T) {
+		// --- Given ---
+		opts := []nomix.Option{
+			nomix.WithSliceEncoder(nomix.PGArray{}),
+			nomix.WithTimeFormat(time.DateOnly),
+		}
+		tag, err := CreateTimeSlice("name", []time.Time{}, opts...)
+		assert.NoError(t, err)
+
+		// --- When ---
+		err = tag.Scan(`{"2000-01-02"}`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		tim := time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, []time.Time{tim}, tag.Get())
+	})
+
+	t.Run("value round trip with custom time format", func(t *testing.T) {
+		// --- Given ---
+		loc := time.FixedZone("UTC+2", 2*60*60)
+		tim := time.Date(2000, 1, 2, 3, 4, 5, 0, loc)
+		opts := []nomix.Option{
+			nomix.WithTimeFormat(time.DateTime),
+			nomix.WithTimeLoc(loc),
+		}
+		tag, err := CreateTimeSlice("name", []time.Time{tim}, opts...)
+		assert.NoError(t, err)
+		val, err := tag.Value()
+		assert.NoError(t, err)
+
+		// --- When ---
+		err = tag.Scan(val)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `["2000-01-02 03:04:05"]`, val)
+		assert.Equal(t, []time.Time{tim}, tag.Get())
+	})
+
+	t.Run("error - invalid format", func(t *testing.T) {
+		// --- Given ---
+		tag := NewTimeSlice("name")
+
+		// --- When ---
+		err := tag.Scan(`["abc"]`)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.ErrorContain(t, "name: ", err)
+	})
+}
@@ -189,3 +189,55 @@ func Test_sqlValueTime(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Exact(t, time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC), have)
 }
+
+func Test_sqlScanTime(t *testing.T) {
+	t.Run("time", func(t *testing.T) {
+		// --- Given ---
+		tim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		// --- When ---
+		have, err := sqlScanTime(tim)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Exact(t, tim, have)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanTime("2000-01-02T03:04:05Z")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		assert.Exact(t, want, have)
+	})
+
+	t.Run("byte slice", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanTime([]byte("2000-01-02T03:04:05Z"))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		assert.Exact(t, want, have)
+	})
+
+	t.Run("error - invalid format", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanTime("abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Zero(t, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanTime(int64(42))
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Zero(t, have)
+	})
+}
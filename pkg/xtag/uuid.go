@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xtag
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+// UUID is a tag representing a single UUID value.
+type UUID = nomix.Single[[16]byte]
+
+// uuidSpec defines the [nomix.KindSpec] for [UUID] type.
+var uuidSpec = nomix.NewKindSpec(
+	nomix.KindUUID,
+	nomix.TagCreateFunc(CreateUUID),
+	nomix.TagParseFunc(ParseUUID),
+)
+
+// UUIDSpec returns a [nomix.KindSpec] for [UUID] type.
+func UUIDSpec() nomix.KindSpec { return uuidSpec }
+
+// NewUUID returns a new instance of [UUID].
+func NewUUID(name string, val [16]byte) *UUID {
+	return nomix.NewSingle(
+		name,
+		val,
+		nomix.KindUUID,
+		FormatUUID,
+		sqlValueUUID,
+		sqlScanUUID,
+	)
+}
+
+// CreateUUID casts the value to [16]byte. Returns the [UUID] instance with the
+// given name and nil error if the value is a [16]byte, a string in one of the
+// formats supported by [ParseUUID] or a []byte holding either 16 raw bytes or
+// the text representation. Returns nil and error if the value's type is not a
+// supported type or the value is not a valid UUID representation.
+func CreateUUID(name string, val any, _ ...nomix.Option) (*UUID, error) {
+	v, err := createUUID(val, nomix.Options{})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return NewUUID(name, v), nil
+}
+
+// createUUID casts the value to [16]byte. Returns the UUID and nil error on
+// success. Returns zero value and [nomix.ErrInvType] if the value's type is
+// not supported, or [nomix.ErrInvFormat] if the value is not a valid UUID
+// representation.
+func createUUID(val any, _ nomix.Options) ([16]byte, error) {
+	switch v := val.(type) {
+	case [16]byte:
+		return v, nil
+	case string:
+		return parseUUID(v)
+	case []byte:
+		if len(v) == 16 {
+			return [16]byte(v), nil
+		}
+		return parseUUID(string(v))
+	}
+	return [16]byte{}, nomix.ErrInvType
+}
+
+// ParseUUID parses a string representation of the UUID tag. It supports the
+// RFC 4122 / RFC 9562 text formats:
+//
+//	6ba7b810-9dad-11d1-80b4-00c04fd430c8
+//	{6ba7b810-9dad-11d1-80b4-00c04fd430c8}
+//	urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8
+//	6ba7b8109dad11d180b400c04fd430c8
+//
+// The hexadecimal digits are case-insensitive.
+func ParseUUID(name, val string, _ ...nomix.Option) (*UUID, error) {
+	v, err := parseUUID(val)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return NewUUID(name, v), nil
+}
+
+// parseUUID parses a string representation of UUID in one of the formats
+// described in [ParseUUID]. Returns [nomix.ErrInvFormat] if the string is not
+// a valid UUID representation.
+func parseUUID(val string) ([16]byte, error) {
+	var uuid [16]byte
+	switch len(val) {
+	case 32:
+		for i := range 16 {
+			b, ok := hexByte(val[i*2], val[i*2+1])
+			if !ok {
+				return [16]byte{}, nomix.ErrInvFormat
+			}
+			uuid[i] = b
+		}
+		return uuid, nil
+
+	case 36:
+		// Canonical form.
+
+	case 38:
+		if val[0] != '{' || val[37] != '}' {
+			return [16]byte{}, nomix.ErrInvFormat
+		}
+		val = val[1:37]
+
+	case 45:
+		if !strings.EqualFold(val[:9], "urn:uuid:") {
+			return [16]byte{}, nomix.ErrInvFormat
+		}
+		val = val[9:]
+
+	default:
+		return [16]byte{}, nomix.ErrInvFormat
+	}
+
+	if val[8] != '-' || val[13] != '-' || val[18] != '-' || val[23] != '-' {
+		return [16]byte{}, nomix.ErrInvFormat
+	}
+	offsets := [16]int{
+		0, 2, 4, 6, 9, 11, 14, 16, 19, 21, 24, 26, 28, 30, 32, 34,
+	}
+	for i, off := range offsets {
+		b, ok := hexByte(val[off], val[off+1])
+		if !ok {
+			return [16]byte{}, nomix.ErrInvFormat
+		}
+		uuid[i] = b
+	}
+	return uuid, nil
+}
+
+// FormatUUID returns the canonical, lowercase representation of UUID.
+func FormatUUID(v [16]byte) string {
+	const hex = "0123456789abcdef"
+	buf := make([]byte, 36)
+	pos := 0
+	for i, b := range v {
+		if i == 4 || i == 6 || i == 8 || i == 10 {
+			buf[pos] = '-'
+			pos++
+		}
+		buf[pos] = hex[b>>4]
+		buf[pos+1] = hex[b&0x0f]
+		pos += 2
+	}
+	return string(buf)
+}
+
+// hexByte converts two hexadecimal digits to a byte. Returns false if any of
+// the characters is not a hexadecimal digit.
+func hexByte(hi, lo byte) (byte, bool) {
+	h, okH := hexDigit(hi)
+	l, okL := hexDigit(lo)
+	return h<<4 | l, okH && okL
+}
+
+// hexDigit converts a hexadecimal digit to its value.
+func hexDigit(c byte) (byte, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// sqlValueUUID converts UUID to its canonical string representation. Never
+// returns an error.
+func sqlValueUUID(v [16]byte) (driver.Value, error) {
+	return FormatUUID(v), nil
+}
+
+// sqlScanUUID converts the database value to UUID. It supports the same value
+// types as [CreateUUID].
+func sqlScanUUID(src any) ([16]byte, error) {
+	return createUUID(src, nomix.Options{})
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xtag
+
+import (
+	"fmt"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+// UUIDSlice is a tag representing multiple UUID values.
+type UUIDSlice = nomix.Slice[[16]byte]
+
+// uuidSliceSpec defines the [nomix.KindSpec] for [UUIDSlice] type.
+var uuidSliceSpec = nomix.NewKindSpec(
+	nomix.KindUUIDSlice,
+	nomix.TagCreateFunc(CreateUUIDSlice),
+	nomix.TagParseFunc(ParseUUIDSlice),
+)
+
+// UUIDSliceSpec returns a [nomix.KindSpec] for [UUIDSlice] type.
+func UUIDSliceSpec() nomix.KindSpec { return uuidSliceSpec }
+
+// NewUUIDSlice returns a new instance of [UUIDSlice].
+func NewUUIDSlice(name string, val ...[16]byte) *UUIDSlice {
+	return newUUIDSlice(name, val, nomix.NewOptions())
+}
+
+// newUUIDSlice returns a new instance of [UUIDSlice] using
+// [nomix.Options.SliceEncoder] for the database representation.
+func newUUIDSlice(name string, val [][16]byte, def nomix.Options) *UUIDSlice {
+	valuer, scanner := sliceSQL(def, true, FormatUUID, parseUUID)
+	return nomix.NewSlice(
+		name,
+		val,
+		nomix.KindUUIDSlice,
+		strValueUUIDSlice,
+		valuer,
+		scanner,
+	)
+}
+
+// CreateUUIDSlice casts the value to [][16]byte. Returns the [UUIDSlice]
+// instance with the given name and nil error if the value is a [][16]byte or
+// a []string with elements in one of the formats supported by [ParseUUID].
+// Returns nil and error if the value's type is not a supported type or any
+// of the elements is not a valid UUID representation.
+func CreateUUIDSlice(
+	name string,
+	val any,
+	opts ...nomix.Option,
+) (*UUIDSlice, error) {
+
+	def := nomix.NewOptions(opts...)
+	v, err := createUUIDSlice(val, def)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return newUUIDSlice(name, v, def), nil
+}
+
+// createUUIDSlice casts the value to [][16]byte. Returns the slice and nil
+// error on success. Returns nil and error if the value's type is not a
+// supported type or any of the elements is not a valid UUID representation.
+func createUUIDSlice(val any, _ nomix.Options) ([][16]byte, error) {
+	switch v := val.(type) {
+	case [][16]byte:
+		return v, nil
+	case []string:
+		uuids := make([][16]byte, len(v))
+		for i, str := range v {
+			var err error
+			if uuids[i], err = parseUUID(str); err != nil {
+				return nil, err
+			}
+		}
+		return uuids, nil
+	}
+	return nil, nomix.ErrInvType
+}
+
+// ParseUUIDSlice parses a string representation of the UUID slice tag. Both
+// the JSON-array form, as returned by the String method, and the delimited
+// form are supported. Use [nomix.WithSeparator], [nomix.WithQuote] and
+// [nomix.WithNoTrim] options to configure the delimited form. The elements
+// may be in any of the formats supported by [ParseUUID].
+func ParseUUIDSlice(
+	name string,
+	val string,
+	opts ...nomix.Option,
+) (*UUIDSlice, error) {
+
+	def := nomix.NewOptions(opts...)
+	v, err := parseSlice(val, def, parseUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return newUUIDSlice(name, v, def), nil
+}
+
+// strValueUUIDSlice converts a UUID slice to its string representation.
+func strValueUUIDSlice(v [][16]byte) string {
+	ret := "["
+	for i, val := range v {
+		if i > 0 {
+			ret += ", "
+		}
+		ret += `"` + FormatUUID(val) + `"`
+	}
+	return ret + "]"
+}
@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xtag
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+func Test_UUIDSliceSpec(t *testing.T) {
+	// --- When ---
+	have := UUIDSliceSpec()
+
+	// --- Then ---
+	tag, err := have.TagCreate("name", [][16]byte{tstUUID, {}})
+	assert.NoError(t, err)
+	assert.SameType(t, &UUIDSlice{}, tag)
+	assert.Equal(t, "name", tag.TagName())
+	assert.Equal(t, [][16]byte{tstUUID, {}}, tag.TagValue())
+	assert.Equal(t, nomix.KindUUIDSlice, tag.TagKind())
+
+	tag, err = have.TagParse("name", `["`+tstUUIDStr+`"]`)
+	assert.NoError(t, err)
+	assert.SameType(t, &UUIDSlice{}, tag)
+	assert.Equal(t, "name", tag.TagName())
+	assert.Equal(t, [][16]byte{tstUUID}, tag.TagValue())
+	assert.Equal(t, nomix.KindUUIDSlice, tag.TagKind())
+}
+
+func Test_NewUUIDSlice(t *testing.T) {
+	// --- When ---
+	tag := NewUUIDSlice("name", tstUUID, [16]byte{})
+
+	// --- Then ---
+	assert.SameType(t, &UUIDSlice{}, tag)
+	assert.Equal(t, "name", tag.TagName())
+	assert.Equal(t, [][16]byte{tstUUID, {}}, tag.TagValue())
+	assert.Equal(t, nomix.KindUUIDSlice, tag.TagKind())
+	want := `["` + tstUUIDStr + `", "00000000-0000-0000-0000-000000000000"]`
+	assert.Equal(t, want, tag.String())
+}
+
+func Test_CreateUUIDSlice(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- When ---
+		tag, err := CreateUUIDSlice("name", [][16]byte{tstUUID})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.SameType(t, &UUIDSlice{}, tag)
+		assert.Equal(t, "name", tag.TagName())
+		assert.Equal(t, [][16]byte{tstUUID}, tag.TagValue())
+		assert.Equal(t, nomix.KindUUIDSlice, tag.TagKind())
+		assert.Equal(t, `["`+tstUUIDStr+`"]`, tag.String())
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		tag, err := CreateUUIDSlice("name", 42)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.ErrorContain(t, "name: ", err)
+		assert.Nil(t, tag)
+	})
+}
+
+func Test_ParseUUIDSlice(t *testing.T) {
+	t.Run("json form", func(t *testing.T) {
+		// --- Given ---
+		data := `["` + tstUUIDStr + `", "{` + tstUUIDStr + `}"]`
+
+		// --- When ---
+		tag, err := ParseUUIDSlice("name", data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.SameType(t, &UUIDSlice{}, tag)
+		assert.Equal(t, "name", tag.TagName())
+		assert.Equal(t, [][16]byte{tstUUID, tstUUID}, tag.TagValue())
+		assert.Equal(t, nomix.KindUUIDSlice, tag.TagKind())
+	})
+
+	t.Run("delimited form", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseUUIDSlice("name", tstUUIDStr+","+tstUUIDStr)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, [][16]byte{tstUUID, tstUUID}, tag.TagValue())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		want := NewUUIDSlice("name", [][16]byte{tstUUID, {}}...)
+
+		// --- When ---
+		have, err := ParseUUIDSlice("name", want.String())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, want.TagValue(), have.TagValue())
+	})
+
+	t.Run("error - invalid element", func(t *testing.T) {
+		// --- When ---
+		tag, err := ParseUUIDSlice("name", `["abc"]`)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "name: invalid element format", err)
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, tag)
+	})
+}
+
+func Test_createUUIDSlice(t *testing.T) {
+	t.Run("uuid slice", func(t *testing.T) {
+		// --- When ---
+		have, err := createUUIDSlice([][16]byte{tstUUID}, nomix.Options{})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, [][16]byte{tstUUID}, have)
+	})
+
+	t.Run("string slice", func(t *testing.T) {
+		// --- Given ---
+		val := []string{tstUUIDStr, "{" + tstUUIDStr + "}"}
+
+		// --- When ---
+		have, err := createUUIDSlice(val, nomix.Options{})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, [][16]byte{tstUUID, tstUUID}, have)
+	})
+
+	t.Run("error - invalid string element", func(t *testing.T) {
+		// --- When ---
+		have, err := createUUIDSlice([]string{"abc"}, nomix.Options{})
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - nil value", func(t *testing.T) {
+		// --- When ---
+		have, err := createUUIDSlice(nil, nomix.Options{})
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_UUIDSlice_Value(t *testing.T) {
+	t.Run("default encoder", func(t *testing.T) {
+		// --- Given ---
+		tag := NewUUIDSlice("name", tstUUID, [16]byte{})
+
+		// --- When ---
+		have, err := tag.Value()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `["`+tstUUIDStr+`","`+tstZeroUUIDStr+`"]`, have)
+	})
+
+	t.Run("custom encoder", func(t *testing.T) {
+		// --- Given ---
+		opt := nomix.WithSliceEncoder(nomix.PGArray{})
+		tag, err := CreateUUIDSlice("name", [][16]byte{tstUUID, {}}, opt)
+		assert.NoError(t, err)
+
+		// --- When ---
+		have, err := tag.Value()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{"`+tstUUIDStr+`","`+tstZeroUUIDStr+`"}`, have)
+	})
+}
+
+func Test_UUIDSlice_Scan(t *testing.T) {
+	t.Run("default encoder", func(t *testing.T) {
+		// --- Given ---
+		tag := NewUUIDSlice("name")
+		src := `["` + tstUUIDStr + `","` + tstZeroUUIDStr + `"]`
+
+		// --- When ---
+		err := tag.Scan([]byte(src))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, [][16]byte{tstUUID, {}}, tag.Get())
+	})
+
+	t.Run("custom encoder", func(t *testing.T) {
+		// --- Given ---
+		opt := nomix.WithSliceEncoder(nomix.PGArray{})
+		tag, err := CreateUUIDSlice("name", [][16]byte{tstUUID, {}}[:0], opt)
+		assert.NoError(t, err)
+
+		// --- When ---
+		err = tag.Scan(`{"` + tstUUIDStr + `","` + tstZeroUUIDStr + `"}`)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, [][16]byte{tstUUID, {}}, tag.Get())
+	})
+
+	t.Run("error - invalid format", func(t *testing.T) {
+		// --- Given ---
+		tag := NewUUIDSlice("name")
+
+		// --- When ---
+		err := tag.Scan(`["abc"]`)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.ErrorContain(t, "name: ", err)
+	})
+}
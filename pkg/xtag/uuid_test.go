@@ -0,0 +1,252 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xtag
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+
+	"github.com/ctx42/nomix/pkg/nomix"
+)
+
+// tstUUID is the UUID used in tests.
+var tstUUID = [16]byte{
+	0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1,
+	0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8,
+}
+
+// tstUUIDStr is the canonical string representation of tstUUID.
+const tstUUIDStr = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+// tstZeroUUIDStr is the canonical string representation of zero UUID.
+const tstZeroUUIDStr = "00000000-0000-0000-0000-000000000000"
+
+func Test_UUIDSpec(t *testing.T) {
+	// --- When ---
+	have := UUIDSpec()
+
+	// --- Then ---
+	tag, err := have.TagCreate("name", tstUUID)
+	assert.NoError(t, err)
+	assert.SameType(t, &UUID{}, tag)
+	assert.Equal(t, "name", tag.TagName())
+	assert.Equal(t, tstUUID, tag.TagValue())
+	assert.Equal(t, nomix.KindUUID, tag.TagKind())
+
+	tag, err = have.TagParse("name", tstUUIDStr)
+	assert.NoError(t, err)
+	assert.SameType(t, &UUID{}, tag)
+	assert.Equal(t, "name", tag.TagName())
+	assert.Equal(t, tstUUID, tag.TagValue())
+	assert.Equal(t, nomix.KindUUID, tag.TagKind())
+}
+
+func Test_NewUUID(t *testing.T) {
+	// --- When ---
+	have := NewUUID("name", tstUUID)
+
+	// --- Then ---
+	assert.SameType(t, &UUID{}, have)
+	assert.Equal(t, "name", have.TagName())
+	assert.Equal(t, tstUUID, have.TagValue())
+	assert.Equal(t, nomix.KindUUID, have.TagKind())
+	assert.Equal(t, tstUUIDStr, have.String())
+
+	val, err := have.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, tstUUIDStr, val)
+}
+
+func Test_CreateUUID(t *testing.T) {
+	t.Run("array", func(t *testing.T) {
+		// --- When ---
+		have, err := CreateUUID("name", tstUUID)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.SameType(t, &UUID{}, have)
+		assert.Equal(t, "name", have.TagName())
+		assert.Equal(t, tstUUID, have.TagValue())
+		assert.Equal(t, nomix.KindUUID, have.TagKind())
+		assert.Equal(t, tstUUIDStr, have.String())
+	})
+
+	t.Run("string", func(t *testing.T) {
+		// --- When ---
+		have, err := CreateUUID("name", tstUUIDStr)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, tstUUID, have.TagValue())
+	})
+
+	t.Run("raw bytes", func(t *testing.T) {
+		// --- When ---
+		have, err := CreateUUID("name", tstUUID[:])
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, tstUUID, have.TagValue())
+	})
+
+	t.Run("text bytes", func(t *testing.T) {
+		// --- When ---
+		have, err := CreateUUID("name", []byte(tstUUIDStr))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, tstUUID, have.TagValue())
+	})
+
+	t.Run("error - invalid format", func(t *testing.T) {
+		// --- When ---
+		have, err := CreateUUID("name", "abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.ErrorContain(t, "name: ", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := CreateUUID("name", 42)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.ErrorContain(t, "name: ", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - nil value", func(t *testing.T) {
+		// --- When ---
+		have, err := CreateUUID("name", nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_ParseUUID_success_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		str string
+	}{
+		{"canonical", "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"canonical upper case", "6BA7B810-9DAD-11D1-80B4-00C04FD430C8"},
+		{"braced", "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"},
+		{"urn", "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"urn upper case", "URN:UUID:6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"hex", "6ba7b8109dad11d180b400c04fd430c8"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := ParseUUID("name", tc.str)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tstUUID, have.TagValue())
+			assert.Equal(t, tstUUIDStr, have.String())
+		})
+	}
+}
+
+func Test_ParseUUID_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		str string
+	}{
+		{"empty", ""},
+		{"too short", "6ba7b810-9dad-11d1-80b4-00c04fd430c"},
+		{"too long", "6ba7b810-9dad-11d1-80b4-00c04fd430c88"},
+		{"missing dash", "6ba7b810x9dad-11d1-80b4-00c04fd430c8"},
+		{"invalid hex digit", "6ba7b810-9dad-11d1-80b4-00c04fd430cx"},
+		{"invalid braces", "(6ba7b810-9dad-11d1-80b4-00c04fd430c8)"},
+		{"invalid urn", "urn:uid::6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"invalid hex form", "6ba7b8109dad11d180b400c04fd430cx"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := ParseUUID("name", tc.str)
+
+			// --- Then ---
+			assert.ErrorEqual(t, "name: invalid element format", err)
+			assert.ErrorIs(t, nomix.ErrInvFormat, err)
+			assert.Nil(t, have)
+		})
+	}
+}
+
+func Test_FormatUUID(t *testing.T) {
+	t.Run("uuid", func(t *testing.T) {
+		// --- When ---
+		have := FormatUUID(tstUUID)
+
+		// --- Then ---
+		assert.Equal(t, tstUUIDStr, have)
+	})
+
+	t.Run("nil uuid", func(t *testing.T) {
+		// --- When ---
+		have := FormatUUID([16]byte{})
+
+		// --- Then ---
+		assert.Equal(t, "00000000-0000-0000-0000-000000000000", have)
+	})
+}
+
+func Test_sqlValueUUID(t *testing.T) {
+	// --- When ---
+	have, err := sqlValueUUID(tstUUID)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, tstUUIDStr, have)
+}
+
+func Test_sqlScanUUID(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanUUID(tstUUIDStr)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, tstUUID, have)
+	})
+
+	t.Run("raw bytes", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanUUID(tstUUID[:])
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, tstUUID, have)
+	})
+
+	t.Run("error - invalid format", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanUUID("abc")
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvFormat, err)
+		assert.Equal(t, [16]byte{}, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- When ---
+		have, err := sqlScanUUID(int64(42))
+
+		// --- Then ---
+		assert.ErrorIs(t, nomix.ErrInvType, err)
+		assert.Equal(t, [16]byte{}, have)
+	})
+}
@@ -21,6 +21,7 @@ func RegisterAll(reg *nomix.Registry) {
 	mustRegisterKind(reg, stringSpec)
 	mustRegisterKind(reg, timeSpec)
 	mustRegisterKind(reg, jsonSpec)
+	mustRegisterKind(reg, uuidSpec)
 	mustRegisterKind(reg, byteSliceSpec)
 	mustRegisterKind(reg, intSliceSpec)
 	mustRegisterKind(reg, int64SliceSpec)
@@ -28,6 +29,7 @@ func RegisterAll(reg *nomix.Registry) {
 	mustRegisterKind(reg, boolSliceSpec)
 	mustRegisterKind(reg, stringSliceSpec)
 	mustRegisterKind(reg, timeSliceSpec)
+	mustRegisterKind(reg, uuidSliceSpec)
 
 	mustAssociateType(reg, byte(1), nomix.KindInt64)
 	mustAssociateType(reg, int(1), nomix.KindInt)
@@ -41,6 +43,7 @@ func RegisterAll(reg *nomix.Registry) {
 	mustAssociateType(reg, "string", nomix.KindString)
 	mustAssociateType(reg, time.Time{}, nomix.KindTime)
 	mustAssociateType(reg, json.RawMessage{}, nomix.KindJSON)
+	mustAssociateType(reg, [16]byte{}, nomix.KindUUID)
 
 	mustAssociateType(reg, []byte{}, nomix.KindByteSlice)
 	mustAssociateType(reg, []int{}, nomix.KindIntSlice)
@@ -53,6 +56,7 @@ func RegisterAll(reg *nomix.Registry) {
 	mustAssociateType(reg, []bool{}, nomix.KindBoolSlice)
 	mustAssociateType(reg, []string{}, nomix.KindStringSlice)
 	mustAssociateType(reg, []time.Time{}, nomix.KindTimeSlice)
+	mustAssociateType(reg, [][16]byte{}, nomix.KindUUIDSlice)
 }
 
 // mustAssociateType calls [nomix.Registry.Register], and panics on error.
@@ -4,15 +4,29 @@
 package xtag
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"encoding/json"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
 
 	"github.com/ctx42/nomix/pkg/nomix"
 )
 
+// tstGlobalOnce guards registering specs in the [nomix.GlobalRegistry].
+var tstGlobalOnce sync.Once
+
+// tstGlobalRegistry registers all specs in the [nomix.GlobalRegistry], the
+// first time it is called, and returns the registry.
+func tstGlobalRegistry() *nomix.Registry {
+	tstGlobalOnce.Do(func() { RegisterAll(nomix.GlobalRegistry()) })
+	return nomix.GlobalRegistry()
+}
+
 func Test_RegisterAll_tabular(t *testing.T) {
 	tt := []struct {
 		testN string
@@ -42,6 +56,12 @@ func Test_RegisterAll_tabular(t *testing.T) {
 			nomix.KindJSON,
 			[]byte(`{"A": 1}`),
 		},
+		{
+			"uuid",
+			[16]byte{0x6b, 0xa7, 0xb8, 0x10},
+			nomix.KindUUID,
+			[16]byte{0x6b, 0xa7, 0xb8, 0x10},
+		},
 
 		{"byte slice", []byte{42}, nomix.KindByteSlice, []byte{42}},
 		{"int slice", []int{42}, nomix.KindIntSlice, []int{42}},
@@ -59,6 +79,12 @@ func Test_RegisterAll_tabular(t *testing.T) {
 			nomix.KindTimeSlice,
 			[]time.Time{time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)},
 		},
+		{
+			"uuid slice",
+			[][16]byte{{0x6b, 0xa7, 0xb8, 0x10}},
+			nomix.KindUUIDSlice,
+			[][16]byte{{0x6b, 0xa7, 0xb8, 0x10}},
+		},
 	}
 
 	reg := nomix.NewRegistry()
@@ -74,3 +100,143 @@ func Test_RegisterAll_tabular(t *testing.T) {
 		})
 	}
 }
+
+func Test_TagSet_JSON_round_trip(t *testing.T) {
+	// --- Given ---
+	tim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	uid := [16]byte{0x6b, 0xa7, 0xb8, 0x10}
+
+	set := nomix.NewTagSet()
+	set.TagSet(
+		NewBool("bool", true),
+		NewFloat64("float64", 4.2),
+		NewInt("int", 42),
+		NewInt64("int64", 42),
+		NewJSON("json", json.RawMessage(`{"A":1}`)),
+		NewString("string", `a"b`),
+		NewTime("time", tim),
+		NewUUID("uuid", uid),
+		NewBoolSlice("bool slice", true, false),
+		NewByteSlice("byte slice", 1, 2),
+		NewFloat64Slice("float64 slice", 4.2, 1),
+		NewIntSlice("int slice", 4, 2),
+		NewInt64Slice("int64 slice", 4, 2),
+		NewStringSlice("string slice", "a,b", `c"d`),
+		NewTimeSlice("time slice", tim, tim),
+		NewUUIDSlice("uuid slice", uid, [16]byte{}),
+	)
+
+	reg := nomix.NewRegistry()
+	RegisterAll(reg)
+
+	for _, verbose := range []bool{false, true} {
+		var opts []nomix.Option
+		if verbose {
+			opts = append(opts, nomix.WithVerbose)
+		}
+
+		// --- When ---
+		data, err := nomix.MarshalTagSet(set, opts...)
+		assert.NoError(t, err)
+		have, err := nomix.UnmarshalTagSet(reg, data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, set.TagCount(), have.TagCount())
+		for name, tag := range set.TagGetAll() {
+			got := have.TagGet(name)
+			assert.NotNil(t, got)
+			assert.Equal(t, tag.TagKind(), got.TagKind())
+			assert.Equal(t, tag.TagValue(), got.TagValue())
+		}
+	}
+}
+
+func Test_TagSet_json_Unmarshal_global_registry(t *testing.T) {
+	// --- Given ---
+	tstGlobalRegistry()
+	set := nomix.NewTagSet()
+	set.TagSet(
+		NewInt("A", 42),
+		NewStringSlice("B", "a", "b"),
+		NewUUID("C", [16]byte{0x6b, 0xa7, 0xb8, 0x10}),
+	)
+	data := must.Value(json.Marshal(set))
+
+	// --- When ---
+	var have nomix.TagSet
+	err := json.Unmarshal(data, &have)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, set.MetaGetAll(), have.MetaGetAll())
+	assert.Equal(t, nomix.KindUUID, have.TagGet("C").TagKind())
+}
+
+func Test_Patch_json_Unmarshal_global_registry(t *testing.T) {
+	// --- Given ---
+	tstGlobalRegistry()
+	from := nomix.NewTagSet()
+	from.TagSet(NewInt("A", 1), NewString("B", "b"))
+	to := nomix.NewTagSet()
+	to.TagSet(NewString("A", "1"), NewUUIDSlice("C", [16]byte{1}))
+	data := must.Value(json.Marshal(nomix.Diff(from, to)))
+
+	// --- When ---
+	var have nomix.Patch
+	err := json.Unmarshal(data, &have)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Len(t, 3, have)
+	assert.NoError(t, have.Apply(from, nomix.WithPrecondition))
+	assert.Equal(t, to.MetaGetAll(), from.MetaGetAll())
+}
+
+func Test_Tag_Value_Scan_round_trip(t *testing.T) {
+	tim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	uid := [16]byte{0x6b, 0xa7, 0xb8, 0x10}
+
+	tt := []struct {
+		testN string
+
+		tag  nomix.Tag
+		have nomix.Tag
+	}{
+		{"bool", NewBool("name", true), NewBool("name", false)},
+		{"float64", NewFloat64("name", 4.2), NewFloat64("name", 0)},
+		{"int", NewInt("name", 42), NewInt("name", 0)},
+		{"int64", NewInt64("name", 42), NewInt64("name", 0)},
+		{
+			"json",
+			NewJSON("name", json.RawMessage(`{"A":1}`)),
+			NewJSON("name", nil),
+		},
+		{"string", NewString("name", "abc"), NewString("name", "")},
+		{"time", NewTime("name", tim), NewTime("name", time.Time{})},
+		{"uuid", NewUUID("name", uid), NewUUID("name", [16]byte{})},
+		{"bool slice", NewBoolSlice("name", true, false), NewBoolSlice("name")},
+		{"byte slice", NewByteSlice("name", 1, 2), NewByteSlice("name")},
+		{"float64 slice", NewFloat64Slice("name", 1), NewFloat64Slice("name")},
+		{"int slice", NewIntSlice("name", 4, 2), NewIntSlice("name")},
+		{"int64 slice", NewInt64Slice("name", 4, 2), NewInt64Slice("name")},
+		{"string slice", NewStringSlice("name", "a"), NewStringSlice("name")},
+		{"time slice", NewTimeSlice("name", tim), NewTimeSlice("name")},
+		{"uuid slice", NewUUIDSlice("name", uid), NewUUIDSlice("name")},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			val, err := tc.tag.(driver.Valuer).Value()
+			assert.NoError(t, err)
+
+			// --- When ---
+			err = tc.have.(sql.Scanner).Scan(val)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.tag.TagValue(), tc.have.TagValue())
+		})
+	}
+}